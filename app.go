@@ -13,6 +13,7 @@ import (
 	"distui/handlers"
 	"distui/internal/config"
 	"distui/internal/detection"
+	"distui/internal/forge"
 	"distui/internal/models"
 	"distui/views"
 )
@@ -95,6 +96,9 @@ func initialModel() model {
 	globalConfig, err := config.LoadGlobalConfig()
 	if err != nil {
 		globalConfig = nil
+	} else {
+		forge.SetAccounts(globalConfig.User.ForgeAccounts)
+		forge.SetGitHubAccounts(globalConfig.User.GitHubAccounts)
 	}
 
 	// Try to detect current project
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// Fields of the archive editor, in display order
+const (
+	ArchiveFieldName = iota
+	ArchiveFieldFiles
+	ArchiveFieldWrap
+	ArchiveFieldLinux
+	ArchiveFieldDarwin
+	ArchiveFieldWindows
+	archiveFieldCount
+)
+
+// ArchiveSettingsModel edits archive naming, contents and formats
+type ArchiveSettingsModel struct {
+	NameInput       textinput.Model
+	FilesInput      textinput.Model
+	Wrap            bool
+	Formats         map[string]string
+	Focus           int
+	Preview         []string // Example archive names, plus the npm url when npm is enabled
+	Error           string
+	Project         *models.ProjectInfo
+	NPMEnabled      bool
+	WindowsManagers []string // Enabled package managers that install the windows archive
+	Width           int
+	Height          int
+}
+
+func NewArchiveSettingsModel(settings *models.ArchiveSettings, project *models.ProjectInfo, npmEnabled bool, width, height int) *ArchiveSettingsModel {
+	nameInput := textinput.New()
+	nameInput.Placeholder = generator.DefaultArchiveNameTemplate
+	nameInput.CharLimit = 200
+	nameInput.Width = width - 20
+	nameInput.SetValue(settings.NameTemplate)
+	nameInput.Focus()
+
+	filesInput := textinput.New()
+	filesInput.Placeholder = "LICENSE*, README*, completions/*"
+	filesInput.CharLimit = 500
+	filesInput.Width = width - 20
+	filesInput.SetValue(strings.Join(settings.Files, ", "))
+
+	formats := map[string]string{}
+	for _, goos := range generator.ArchiveOS {
+		formats[goos] = generator.ArchiveFormat(settings, goos)
+	}
+
+	m := &ArchiveSettingsModel{
+		NameInput:  nameInput,
+		FilesInput: filesInput,
+		Wrap:       settings.WrapInDirectory,
+		Formats:    formats,
+		Project:    project,
+		NPMEnabled: npmEnabled,
+		Width:      width,
+		Height:     height,
+	}
+	m.refreshPreview()
+	return m
+}
+
+func (m *ArchiveSettingsModel) Update(msg tea.KeyMsg) (*ArchiveSettingsModel, tea.Cmd) {
+	switch msg.String() {
+	case "tab", "down":
+		m.setFocus((m.Focus + 1) % archiveFieldCount)
+		return m, nil
+	case "shift+tab", "up":
+		m.setFocus((m.Focus + archiveFieldCount - 1) % archiveFieldCount)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	switch m.Focus {
+	case ArchiveFieldName:
+		m.NameInput, cmd = m.NameInput.Update(msg)
+	case ArchiveFieldFiles:
+		m.FilesInput, cmd = m.FilesInput.Update(msg)
+	case ArchiveFieldWrap:
+		if msg.String() == " " || msg.String() == "space" || msg.String() == "left" || msg.String() == "right" {
+			m.Wrap = !m.Wrap
+		}
+	default:
+		goos := m.FocusedOS()
+		switch msg.String() {
+		case " ", "space", "right":
+			m.Formats[goos] = cycleFormat(m.Formats[goos], 1)
+		case "left":
+			m.Formats[goos] = cycleFormat(m.Formats[goos], -1)
+		}
+	}
+
+	m.Error = ""
+	m.refreshPreview()
+	return m, cmd
+}
+
+// FocusedOS returns the goos of the focused format field, or ""
+func (m *ArchiveSettingsModel) FocusedOS() string {
+	if m.Focus < ArchiveFieldLinux {
+		return ""
+	}
+	return generator.ArchiveOS[m.Focus-ArchiveFieldLinux]
+}
+
+func (m *ArchiveSettingsModel) setFocus(field int) {
+	m.Focus = field
+	m.NameInput.Blur()
+	m.FilesInput.Blur()
+	switch field {
+	case ArchiveFieldName:
+		m.NameInput.Focus()
+	case ArchiveFieldFiles:
+		m.FilesInput.Focus()
+	}
+}
+
+// Settings returns the edited settings. tar.gz is the default and not stored.
+func (m *ArchiveSettingsModel) Settings() *models.ArchiveSettings {
+	settings := &models.ArchiveSettings{
+		NameTemplate:    strings.TrimSpace(m.NameInput.Value()),
+		WrapInDirectory: m.Wrap,
+	}
+	for _, file := range strings.Split(m.FilesInput.Value(), ",") {
+		if file = strings.TrimSpace(file); file != "" {
+			settings.Files = append(settings.Files, file)
+		}
+	}
+	for _, goos := range generator.ArchiveOS {
+		if format := m.Formats[goos]; format != "tar.gz" {
+			if settings.Formats == nil {
+				settings.Formats = map[string]string{}
+			}
+			settings.Formats[goos] = format
+		}
+	}
+	return settings
+}
+
+// Validate checks the template renders and that enabled package managers can still use the archives
+func (m *ArchiveSettingsModel) Validate() error {
+	settings := m.Settings()
+	if _, err := generator.PreviewArchiveName(m.Project, settings, "linux", "amd64"); err != nil {
+		return err
+	}
+	if m.NPMEnabled {
+		if _, err := generator.NPMArchiveURL(m.Project, settings); err != nil {
+			return err
+		}
+	}
+	for _, manager := range m.WindowsManagers {
+		if err := generator.CheckWindowsArchives(settings, manager); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *ArchiveSettingsModel) refreshPreview() {
+	settings := m.Settings()
+	m.Preview = nil
+	for _, target := range [][2]string{{"linux", "amd64"}, {"darwin", "arm64"}, {"windows", "amd64"}} {
+		name, err := generator.PreviewArchiveName(m.Project, settings, target[0], target[1])
+		if err != nil {
+			m.Preview = []string{"✗ " + err.Error()}
+			return
+		}
+		m.Preview = append(m.Preview, name)
+	}
+	if m.NPMEnabled {
+		url, err := generator.NPMArchiveURL(m.Project, settings)
+		if err != nil {
+			m.Preview = append(m.Preview, "✗ npm: "+err.Error())
+		} else {
+			m.Preview = append(m.Preview, "npm: "+url)
+		}
+	}
+}
+
+func cycleFormat(current string, step int) string {
+	formats := generator.ArchiveFormats
+	for i, format := range formats {
+		if format == current {
+			return formats[(i+step+len(formats))%len(formats)]
+		}
+	}
+	return formats[0]
+}
+
+// archiveSummary is the one-line description shown in the Build tab
+func archiveSummary(settings *models.ArchiveSettings) string {
+	formats := generator.ArchiveFormat(settings, "linux")
+	var overrides []string
+	for _, goos := range generator.ArchiveOS[1:] {
+		if format := generator.ArchiveFormat(settings, goos); format != formats {
+			overrides = append(overrides, fmt.Sprintf("%s: %s", goos, format))
+		}
+	}
+	if len(overrides) > 0 {
+		formats += " (" + strings.Join(overrides, ", ") + ")"
+	}
+
+	files := "no extra files"
+	if len(settings.Files) > 0 {
+		files = strings.Join(settings.Files, ", ")
+	}
+	return formats + " • " + files
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/brew"
+	"distui/internal/executor"
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// BrewPreview is the formula or cask GoReleaser would push, rendered locally
+type BrewPreview struct {
+	File     string // Path inside the tap
+	Content  string
+	Problems []string // Structural lint
+	Audited  bool     // brew was available and audit ran
+	Audit    string   // brew audit output
+	AuditErr string
+	Offset   int // Scroll offset in Content
+}
+
+type brewPreviewMsg struct {
+	preview *BrewPreview
+	err     error
+}
+
+// renderBrewPreviewCmd renders the tap file from the goreleaser config on disk and fake artifacts
+func renderBrewPreviewCmd(project *models.ProjectInfo, config *models.ProjectConfig) tea.Cmd {
+	return func() tea.Msg {
+		path := generator.FindGoReleaserConfig(project.Path)
+		if path == "" {
+			return brewPreviewMsg{err: fmt.Errorf("no goreleaser config found")}
+		}
+		spec, err := brew.LoadSpec(path)
+		if err != nil {
+			return brewPreviewMsg{err: err}
+		}
+		artifacts, err := brew.FakeArtifacts(project, generator.ArchiveSettingsFor(config))
+		if err != nil {
+			return brewPreviewMsg{err: err}
+		}
+
+		content := brew.Render(spec, artifacts)
+		preview := &BrewPreview{File: spec.File(), Content: content, Problems: brew.Lint(spec, content)}
+
+		if executor.BrewInstalled() {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+			preview.Audited = true
+			preview.Audit, err = executor.AuditBrewFile(ctx, spec, content)
+			if err != nil {
+				preview.AuditErr = err.Error()
+			}
+		}
+		return brewPreviewMsg{preview: preview}
+	}
+}
+
+// startBrewPreview renders and checks the tap file for the saved Homebrew settings
+func (m *ConfigureModel) startBrewPreview() tea.Cmd {
+	if m.DetectedProject == nil || m.ProjectConfig == nil || m.ProjectConfig.Config == nil {
+		return nil
+	}
+	homebrew := m.ProjectConfig.Config.Distributions.Homebrew
+	if homebrew == nil || !homebrew.Enabled {
+		m.CreateStatus = "Enable Homebrew before previewing the formula"
+		return tea.Tick(2*time.Second, func(t time.Time) tea.Msg { return struct{}{} })
+	}
+	if m.NeedsRegeneration {
+		m.CreateStatus = "Generate release files [R] before previewing the formula"
+		return tea.Tick(2*time.Second, func(t time.Time) tea.Msg { return struct{}{} })
+	}
+
+	m.GeneratingFiles = true
+	m.GenerateStatus = "Rendering Homebrew " + brewKind(homebrew) + "..."
+	m.BrewPreview = nil
+	m.BrewPreviewError = ""
+	return tea.Batch(m.CreateSpinner.Tick, renderBrewPreviewCmd(m.DetectedProject, m.ProjectConfig))
+}
+
+func brewKind(homebrew *models.HomebrewConfig) string {
+	if generator.HomebrewIsCask(homebrew) {
+		return "cask"
+	}
+	return "formula"
+}
+
+// Scroll moves the preview by delta lines within the rendered file
+func (p *BrewPreview) Scroll(delta int) {
+	p.Offset += delta
+	if max := strings.Count(p.Content, "\n") - 1; p.Offset > max {
+		p.Offset = max
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+}
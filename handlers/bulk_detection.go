@@ -96,6 +96,7 @@ func ImportDetectedDistributions(distributions []detection.DetectedDistribution,
 				Enabled:     true,
 				TapRepo:     globalConfig.User.DefaultHomebrewTap,
 				FormulaName: dist.Name,
+				Package:     "formula", // Found as a formula in the tap, migrating is up to the user
 			}
 		}
 
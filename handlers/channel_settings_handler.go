@@ -0,0 +1,626 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/detection"
+	"distui/internal/executor"
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// errNeedsConfirm stops a save until the editor's Confirm question is answered
+var errNeedsConfirm = errors.New("needs confirmation")
+
+// ChannelField is one editable setting of a distribution channel
+type ChannelField struct {
+	Label    string
+	Input    textinput.Model
+	Options  []string // Set for multi-select fields, Input is unused then
+	Selected []bool
+	Cursor   int
+}
+
+// ChannelSettingsModel edits the text settings of a distribution channel
+type ChannelSettingsModel struct {
+	Key    string // DistributionItem key of the channel
+	Title  string
+	Hint   string
+	Fields []ChannelField
+	Focus  int
+	Error  string
+	// Confirm is a question the user answers with y before the settings are saved
+	Confirm   string
+	Confirmed bool
+	Width     int
+	Height    int
+}
+
+func NewChannelSettingsModel(key, title string, width, height int) *ChannelSettingsModel {
+	return &ChannelSettingsModel{
+		Key:    key,
+		Title:  title,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// AddField appends a text field, the first one added gets focus
+func (m *ChannelSettingsModel) AddField(label, value, placeholder string) {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.CharLimit = 200
+	input.Width = m.Width - 30
+	input.SetValue(value)
+	if len(m.Fields) == 0 {
+		input.Focus()
+	}
+	m.Fields = append(m.Fields, ChannelField{Label: label, Input: input})
+}
+
+// AddOptions appends a multi-select field with the selected options checked
+func (m *ChannelSettingsModel) AddOptions(label string, options, selected []string) {
+	field := ChannelField{Label: label, Options: options, Selected: make([]bool, len(options))}
+	for i, option := range options {
+		for _, s := range selected {
+			if s == option {
+				field.Selected[i] = true
+			}
+		}
+	}
+	m.Fields = append(m.Fields, field)
+}
+
+func (m *ChannelSettingsModel) Update(msg tea.KeyMsg) (*ChannelSettingsModel, tea.Cmd) {
+	if len(m.Fields) == 0 {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "tab", "down":
+		m.setFocus((m.Focus + 1) % len(m.Fields))
+		return m, nil
+	case "shift+tab", "up":
+		m.setFocus((m.Focus + len(m.Fields) - 1) % len(m.Fields))
+		return m, nil
+	}
+
+	if field := &m.Fields[m.Focus]; len(field.Options) > 0 {
+		switch msg.String() {
+		case "left":
+			field.Cursor = (field.Cursor + len(field.Options) - 1) % len(field.Options)
+		case "right":
+			field.Cursor = (field.Cursor + 1) % len(field.Options)
+		case " ", "space":
+			field.Selected[field.Cursor] = !field.Selected[field.Cursor]
+			m.Error = ""
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Fields[m.Focus].Input, cmd = m.Fields[m.Focus].Input.Update(msg)
+	m.Error = ""
+	return m, cmd
+}
+
+func (m *ChannelSettingsModel) setFocus(field int) {
+	if len(m.Fields[m.Focus].Options) == 0 {
+		m.Fields[m.Focus].Input.Blur()
+	}
+	m.Focus = field
+	if len(m.Fields[m.Focus].Options) == 0 {
+		m.Fields[m.Focus].Input.Focus()
+	}
+}
+
+// Value returns the trimmed value of field i
+func (m *ChannelSettingsModel) Value(i int) string {
+	if i < 0 || i >= len(m.Fields) {
+		return ""
+	}
+	return strings.TrimSpace(m.Fields[i].Input.Value())
+}
+
+// List splits the comma separated value of field i
+func (m *ChannelSettingsModel) List(i int) []string {
+	var values []string
+	for _, value := range strings.Split(m.Value(i), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// SelectedOptions returns the checked options of multi-select field i
+func (m *ChannelSettingsModel) SelectedOptions(i int) []string {
+	if i < 0 || i >= len(m.Fields) {
+		return nil
+	}
+	var selected []string
+	for j, option := range m.Fields[i].Options {
+		if m.Fields[i].Selected[j] {
+			selected = append(selected, option)
+		}
+	}
+	return selected
+}
+
+// openChannelEditor opens the settings editor for channels that have one
+func (m *ConfigureModel) openChannelEditor(key string) bool {
+	if m.ProjectConfig == nil || m.ProjectConfig.Config == nil {
+		return false
+	}
+	dists := &m.ProjectConfig.Config.Distributions
+
+	switch key {
+	case "homebrew":
+		homebrew := m.defaultHomebrewConfig()
+		if dists.Homebrew != nil {
+			homebrew = mergeHomebrewDefaults(dists.Homebrew, func() *models.HomebrewConfig { return homebrew })
+		}
+		model := NewChannelSettingsModel(key, "HOMEBREW SETTINGS", m.Width, m.Height)
+		model.AddField("Package:", homebrew.Package, "cask or formula")
+		model.AddField("Tap repo:", homebrew.TapRepo, "owner/homebrew-tap")
+		model.AddField("Description:", homebrew.Description, "What the tool does")
+		model.AddField("License:", homebrew.License, "SPDX id, e.g. MIT")
+		model.Hint = "Casks install the prebuilt binary and clear the macOS quarantine flag on install"
+		asCask := *homebrew
+		asCask.Enabled, asCask.Package = true, "cask"
+		if migration := pendingFormulaMigration(&asCask, m.DetectedProject); migration != nil {
+			model.Hint += ". As a cask, Formula/" + migration.Name + ".rb in " + migration.TapPath +
+				" can be retired after the next release and brew moves existing installs over"
+		}
+		m.ChannelModel = model
+	case "npm":
+		npm := models.NPMConfig{}
+		if dists.NPM != nil {
+			npm = *dists.NPM
+		}
+		if npm.PackageName == "" && m.DetectedProject != nil {
+			npm.PackageName = generator.NPMPackageName(m.DetectedProject, m.ProjectConfig)
+		}
+		if npm.Mode == "" {
+			npm.Mode = "postinstall"
+		}
+		npm.Publish = generator.NPMPublishMode(&npm)
+		model := NewChannelSettingsModel(key, "NPM SETTINGS", m.Width, m.Height)
+		model.AddField("Package name:", npm.PackageName, "tool or @scope/tool")
+		model.AddField("Mode:", npm.Mode, "postinstall or native")
+		model.AddField("Publish:", npm.Publish, "commit, pretag or staged")
+		model.AddField("Registry:", npm.Registry, generator.DefaultNPMRegistry)
+		model.AddField("Access:", npm.Access, "public or restricted")
+		model.AddField("Dist-tag:", npm.Tag, "latest, next or beta")
+		model.AddField("Token env:", npm.TokenEnv, "NPM_TOKEN, empty uses ~/.npmrc")
+		model.AddField("Other registries:", generator.FormatNPMRegistries(npm.ExtraRegistries), "https://npm.example.com/=VERDACCIO_TOKEN")
+		var provenance []string
+		if npm.Provenance {
+			provenance = []string{"provenance"}
+		}
+		model.AddOptions("Attestation:", []string{"provenance"}, provenance)
+		model.Hint = "postinstall: golang-npm downloads the release archive on install. native: one package per platform " +
+			"with the binary, works with --ignore-scripts and behind proxies. Publish pretag or staged avoids the bump commit after the tag. " +
+			"Provenance is only signed in GitHub Actions and only for npmjs"
+		m.ChannelModel = model
+	case "winget":
+		winget := generator.DefaultWingetConfig(m.DetectedProject)
+		if dists.Winget != nil {
+			winget = mergeWingetDefaults(dists.Winget, winget)
+		}
+		model := NewChannelSettingsModel(key, "WINGET SETTINGS", m.Width, m.Height)
+		model.AddField("Package identifier:", winget.PackageIdentifier, "Publisher.Package")
+		model.AddField("Publisher:", winget.Publisher, "Acme Inc")
+		model.AddField("License:", winget.License, "MIT")
+		model.AddField("Short description:", winget.ShortDescription, "What the tool does")
+		model.AddField("Fork repo:", winget.ForkRepo, "owner/winget-pkgs")
+		model.Hint = "GoReleaser pushes the manifest to your fork and opens a PR against " + generator.WingetBaseRepo
+		m.ChannelModel = model
+	case "aur":
+		aur := m.defaultAURConfig()
+		if dists.AUR != nil {
+			aur = mergeAURDefaults(dists.AUR, func() *models.AURConfig { return aur })
+		}
+		model := NewChannelSettingsModel(key, "AUR SETTINGS", m.Width, m.Height)
+		model.AddField("Package name:", aur.PackageName, "tool-bin")
+		model.AddField("Maintainer:", aur.Maintainer, "Jane Doe <jane@example.com>")
+		model.AddField("License:", aur.License, "MIT")
+		model.AddField("SSH private key:", aur.PrivateKeyPath, generator.DefaultAURKeyPath)
+		model.Hint = "The key must be registered with your AUR account, GoReleaser pushes the PKGBUILD over SSH"
+		m.ChannelModel = model
+	case "nfpm":
+		nfpm := m.defaultNFPMConfig()
+		if dists.NFPM != nil {
+			nfpm = mergeNFPMDefaults(dists.NFPM, func() *models.NFPMConfig { return nfpm })
+		}
+		var configFiles []string
+		for _, file := range nfpm.ConfigFiles {
+			configFiles = append(configFiles, file.Src+":"+file.Dst)
+		}
+		model := NewChannelSettingsModel(key, "LINUX PACKAGE SETTINGS", m.Width, m.Height)
+		model.AddOptions("Formats:", generator.NFPMFormats, nfpm.Formats)
+		model.AddField("Maintainer:", nfpm.Maintainer, "Jane Doe <jane@example.com>")
+		model.AddField("Vendor:", nfpm.Vendor, "Acme Inc")
+		model.AddField("License:", nfpm.License, "MIT")
+		model.AddField("Dependencies:", strings.Join(nfpm.Dependencies, ", "), "git, ca-certificates")
+		model.AddField("Config files:", strings.Join(configFiles, ", "), "config.yaml:/etc/tool/config.yaml")
+		model.AddField("Systemd units:", strings.Join(nfpm.SystemdUnits, ", "), "packaging/tool.service")
+		model.AddField("Pre-install:", nfpm.Scripts.PreInstall, "packaging/preinstall.sh")
+		model.AddField("Post-install:", nfpm.Scripts.PostInstall, "packaging/postinstall.sh")
+		model.AddField("Pre-remove:", nfpm.Scripts.PreRemove, "packaging/preremove.sh")
+		model.AddField("Post-remove:", nfpm.Scripts.PostRemove, "packaging/postremove.sh")
+		model.Hint = "[←/→] and [Space] pick formats. Lists are comma separated, paths are relative to the repo"
+		m.ChannelModel = model
+	case "docker":
+		docker := m.defaultDockerConfig()
+		if dists.Docker != nil {
+			docker = mergeDockerDefaults(dists.Docker, func() *models.DockerConfig { return docker })
+		}
+		model := NewChannelSettingsModel(key, "CONTAINER IMAGE SETTINGS", m.Width, m.Height)
+		model.AddField("Registry:", docker.Registry, generator.DefaultRegistry)
+		model.AddField("Image:", docker.Image, "owner/tool")
+		model.AddField("Dockerfile:", docker.Dockerfile, generator.GeneratedDockerfile)
+		model.AddField("Base image:", docker.BaseImage, "distroless or scratch")
+		model.AddOptions("Platforms:", generator.DockerPlatforms, docker.Platforms)
+		model.Hint = "The base image is used when distui generates " + generator.GeneratedDockerfile + ", scratch has no CA certificates"
+		m.ChannelModel = model
+	case "nix":
+		nix := generator.DefaultNixConfig(m.DetectedProject)
+		if dists.Nix != nil {
+			nix = mergeNixDefaults(dists.Nix, nix)
+		}
+		model := NewChannelSettingsModel(key, "NIX SETTINGS", m.Width, m.Height)
+		model.AddField("Mode:", nix.Mode, "nur or flake")
+		model.AddField("NUR repository:", nix.Repository, "owner/nur-packages")
+		model.AddField("Derivation path:", nix.Path, "pkgs/tool/default.nix")
+		model.AddField("License:", nix.License, "mit")
+		model.AddField("Description:", nix.Description, "What the tool does")
+		model.Hint = "nur: GoReleaser pushes a derivation to your NUR repo. flake: distui generates " + generator.FlakeFile + " with buildGoModule"
+		m.ChannelModel = model
+	case "go_install":
+		proxy := ""
+		if dists.GoModule != nil {
+			proxy = dists.GoModule.Proxy
+		}
+		model := NewChannelSettingsModel(key, "GO MODULE SETTINGS", m.Width, m.Height)
+		model.AddField("Proxy:", proxy, executor.DefaultGoProxy)
+		model.Hint = "After tagging, distui requests the version from the proxy so go install ...@latest sees it right away. " +
+			"pkg.go.dev only indexes from " + executor.DefaultGoProxy
+		m.ChannelModel = model
+	default:
+		return false
+	}
+
+	m.CurrentView = ChannelSettingsView
+	return true
+}
+
+// saveChannelSettings validates and stores the editor result
+func (m *ConfigureModel) saveChannelSettings() error {
+	if m.ChannelModel == nil || m.ProjectConfig == nil || m.ProjectConfig.Config == nil {
+		return nil
+	}
+	dists := &m.ProjectConfig.Config.Distributions
+	editor := m.ChannelModel
+
+	switch editor.Key {
+	case "homebrew":
+		homebrew := models.HomebrewConfig{}
+		if dists.Homebrew != nil {
+			homebrew = *dists.Homebrew
+		}
+		homebrew.Package = editor.Value(0)
+		homebrew.TapRepo = editor.Value(1)
+		homebrew.Description = editor.Value(2)
+		homebrew.License = editor.Value(3)
+		if err := generator.ValidateHomebrewConfig(&homebrew); err != nil {
+			return err
+		}
+		if !generator.HomebrewIsCask(&homebrew) {
+			homebrew.MigrateFormula = false
+		} else if !homebrew.MigrateFormula {
+			asCask := homebrew
+			asCask.Enabled = true
+			if migration := pendingFormulaMigration(&asCask, m.DetectedProject); migration != nil {
+				if !editor.Confirmed {
+					editor.Confirm = fmt.Sprintf("Switching to a cask removes Formula/%s.rb from %s and pushes the change after the next release. Continue? [y/n]",
+						migration.Name, homebrew.TapRepo)
+					return errNeedsConfirm
+				}
+				homebrew.MigrateFormula = true
+			}
+		}
+		dists.Homebrew = &homebrew
+	case "npm":
+		npm := models.NPMConfig{}
+		if dists.NPM != nil {
+			npm = *dists.NPM
+		}
+		npm.PackageName = editor.Value(0)
+		npm.Mode = editor.Value(1)
+		npm.Publish = editor.Value(2)
+		npm.Registry = editor.Value(3)
+		npm.Access = editor.Value(4)
+		npm.Tag = editor.Value(5)
+		npm.TokenEnv = editor.Value(6)
+		npm.ExtraRegistries = generator.ParseNPMRegistries(editor.List(7))
+		npm.Provenance = len(editor.SelectedOptions(8)) > 0
+		if err := generator.ValidateNPMConfig(&npm); err != nil {
+			return err
+		}
+		dists.NPM = &npm
+	case "winget":
+		winget := &models.WingetConfig{
+			PackageIdentifier: editor.Value(0),
+			Publisher:         editor.Value(1),
+			License:           editor.Value(2),
+			ShortDescription:  editor.Value(3),
+			ForkRepo:          editor.Value(4),
+		}
+		if err := generator.ValidateWingetConfig(winget); err != nil {
+			return err
+		}
+		winget.Enabled = dists.Winget != nil && dists.Winget.Enabled
+		dists.Winget = winget
+	case "aur":
+		aur := &models.AURConfig{
+			PackageName:    editor.Value(0),
+			Maintainer:     editor.Value(1),
+			License:        editor.Value(2),
+			PrivateKeyPath: editor.Value(3),
+		}
+		if err := generator.ValidateAURConfig(aur); err != nil {
+			return err
+		}
+		aur.Enabled = dists.AUR != nil && dists.AUR.Enabled
+		dists.AUR = aur
+	case "nfpm":
+		nfpm := &models.NFPMConfig{
+			Formats:      editor.SelectedOptions(0),
+			Maintainer:   editor.Value(1),
+			Vendor:       editor.Value(2),
+			License:      editor.Value(3),
+			Dependencies: editor.List(4),
+			SystemdUnits: editor.List(6),
+			Scripts: models.NFPMScripts{
+				PreInstall:  editor.Value(7),
+				PostInstall: editor.Value(8),
+				PreRemove:   editor.Value(9),
+				PostRemove:  editor.Value(10),
+			},
+		}
+		for _, file := range editor.List(5) {
+			src, dst, _ := strings.Cut(file, ":")
+			nfpm.ConfigFiles = append(nfpm.ConfigFiles, models.NFPMContent{Src: strings.TrimSpace(src), Dst: strings.TrimSpace(dst)})
+		}
+		if err := generator.ValidateNFPMConfig(nfpm); err != nil {
+			return err
+		}
+		nfpm.Enabled = dists.NFPM != nil && dists.NFPM.Enabled
+		dists.NFPM = nfpm
+	case "docker":
+		docker := &models.DockerConfig{
+			Registry:   editor.Value(0),
+			Image:      editor.Value(1),
+			Dockerfile: editor.Value(2),
+			BaseImage:  editor.Value(3),
+			Platforms:  editor.SelectedOptions(4),
+		}
+		if err := generator.ValidateDockerConfig(docker); err != nil {
+			return err
+		}
+		docker.Enabled = dists.Docker != nil && dists.Docker.Enabled
+		dists.Docker = docker
+	case "nix":
+		nix := &models.NixConfig{
+			Mode:        editor.Value(0),
+			Repository:  editor.Value(1),
+			Path:        editor.Value(2),
+			License:     editor.Value(3),
+			Description: editor.Value(4),
+		}
+		if err := generator.ValidateNixConfig(nix); err != nil {
+			return err
+		}
+		nix.Enabled = dists.Nix != nil && dists.Nix.Enabled
+		dists.Nix = nix
+	case "go_install":
+		goModule := &models.GoModuleConfig{Proxy: editor.Value(0)}
+		if err := executor.ValidateGoProxy(goModule.Proxy); err != nil {
+			return err
+		}
+		goModule.Enabled = dists.GoModule != nil && dists.GoModule.Enabled
+		dists.GoModule = goModule
+	}
+
+	if err := m.saveConfig(); err != nil {
+		return err
+	}
+	m.refreshDistributionsList()
+	return nil
+}
+
+// defaultHomebrewConfig takes the description from the GitHub repo and the tap from the global settings
+func (m *ConfigureModel) defaultHomebrewConfig() *models.HomebrewConfig {
+	description := ""
+	if m.DetectedProject != nil && m.DetectedProject.Repository != nil && m.DetectedProject.Repository.Owner != "" {
+		description, _ = detection.FetchRepoDescription(m.DetectedProject.Repository.Owner, m.DetectedProject.Repository.Name)
+	}
+	homebrew := generator.DefaultHomebrewConfig(m.DetectedProject, description)
+	if m.GlobalConfig != nil {
+		homebrew.TapRepo = m.GlobalConfig.User.DefaultHomebrewTap
+	}
+	return homebrew
+}
+
+// mergeHomebrewDefaults fills the empty fields of saved, defaults is only called
+// when package or description are missing since it asks GitHub for the description.
+// A saved tap without a package is a formula, only new setups default to a cask.
+func mergeHomebrewDefaults(saved *models.HomebrewConfig, defaults func() *models.HomebrewConfig) *models.HomebrewConfig {
+	merged := *saved
+	if merged.Package == "" && merged.TapRepo != "" {
+		merged.Package = "formula"
+	}
+	if merged.Package != "" && merged.Description != "" {
+		return &merged
+	}
+	def := defaults()
+	if merged.Package == "" {
+		merged.Package = def.Package
+	}
+	if merged.TapRepo == "" {
+		merged.TapRepo = def.TapRepo
+	}
+	if merged.Description == "" {
+		merged.Description = def.Description
+	}
+	if merged.License == "" {
+		merged.License = def.License
+	}
+	return &merged
+}
+
+// mergeWingetDefaults fills the empty fields of saved with defaults
+func mergeWingetDefaults(saved, defaults *models.WingetConfig) *models.WingetConfig {
+	merged := *saved
+	if merged.PackageIdentifier == "" {
+		merged.PackageIdentifier = defaults.PackageIdentifier
+	}
+	if merged.Publisher == "" {
+		merged.Publisher = defaults.Publisher
+	}
+	if merged.License == "" {
+		merged.License = defaults.License
+	}
+	if merged.ShortDescription == "" {
+		merged.ShortDescription = defaults.ShortDescription
+	}
+	if merged.ForkRepo == "" {
+		merged.ForkRepo = defaults.ForkRepo
+	}
+	return &merged
+}
+
+// defaultAURConfig uses the global git identity as maintainer
+func (m *ConfigureModel) defaultAURConfig() *models.AURConfig {
+	maintainer := ""
+	if env, err := detection.DetectUserEnvironment(); err == nil {
+		maintainer = generator.Maintainer(env.GitName, env.GitEmail)
+	}
+	return generator.DefaultAURConfig(m.DetectedProject, maintainer)
+}
+
+// mergeAURDefaults fills the empty fields of saved, defaults is only called when needed
+func mergeAURDefaults(saved *models.AURConfig, defaults func() *models.AURConfig) *models.AURConfig {
+	merged := *saved
+	if merged.PackageName != "" && merged.Maintainer != "" && merged.License != "" && merged.PrivateKeyPath != "" {
+		return &merged
+	}
+	def := defaults()
+	if merged.PackageName == "" {
+		merged.PackageName = def.PackageName
+	}
+	if merged.Maintainer == "" {
+		merged.Maintainer = def.Maintainer
+	}
+	if merged.License == "" {
+		merged.License = def.License
+	}
+	if merged.PrivateKeyPath == "" {
+		merged.PrivateKeyPath = def.PrivateKeyPath
+	}
+	return &merged
+}
+
+// defaultNFPMConfig uses the global git identity as maintainer
+func (m *ConfigureModel) defaultNFPMConfig() *models.NFPMConfig {
+	maintainer := ""
+	if env, err := detection.DetectUserEnvironment(); err == nil {
+		maintainer = generator.Maintainer(env.GitName, env.GitEmail)
+	}
+	return generator.DefaultNFPMConfig(m.DetectedProject, maintainer)
+}
+
+// mergeNFPMDefaults fills the empty required fields of saved, defaults is only called when needed
+func mergeNFPMDefaults(saved *models.NFPMConfig, defaults func() *models.NFPMConfig) *models.NFPMConfig {
+	merged := *saved
+	if len(merged.Formats) > 0 && merged.Maintainer != "" && merged.License != "" {
+		return &merged
+	}
+	def := defaults()
+	if len(merged.Formats) == 0 {
+		merged.Formats = def.Formats
+	}
+	if merged.Maintainer == "" {
+		merged.Maintainer = def.Maintainer
+	}
+	if merged.Vendor == "" {
+		merged.Vendor = def.Vendor
+	}
+	if merged.License == "" {
+		merged.License = def.License
+	}
+	return &merged
+}
+
+// defaultDockerConfig uses the project's Dockerfile when GoReleaser can build with it
+func (m *ConfigureModel) defaultDockerConfig() *models.DockerConfig {
+	dockerfile := ""
+	if m.DetectedProject != nil {
+		if info := detection.DetectDockerfile(m.DetectedProject.Path); info != nil && !info.BuildsFromSource {
+			dockerfile = info.Path
+		}
+	}
+	return generator.DefaultDockerConfig(m.DetectedProject, dockerfile)
+}
+
+// mergeDockerDefaults fills the empty fields of saved, defaults is only called when needed
+func mergeDockerDefaults(saved *models.DockerConfig, defaults func() *models.DockerConfig) *models.DockerConfig {
+	merged := *saved
+	if merged.Registry != "" && merged.Image != "" && merged.Dockerfile != "" && merged.BaseImage != "" && len(merged.Platforms) > 0 {
+		return &merged
+	}
+	def := defaults()
+	if merged.Registry == "" {
+		merged.Registry = def.Registry
+	}
+	if merged.Image == "" {
+		merged.Image = def.Image
+	}
+	if merged.Dockerfile == "" {
+		merged.Dockerfile = def.Dockerfile
+	}
+	if merged.BaseImage == "" {
+		merged.BaseImage = def.BaseImage
+	}
+	if len(merged.Platforms) == 0 {
+		merged.Platforms = def.Platforms
+	}
+	return &merged
+}
+
+// mergeNixDefaults fills the empty fields of saved with defaults
+func mergeNixDefaults(saved, defaults *models.NixConfig) *models.NixConfig {
+	merged := *saved
+	if merged.Mode == "" {
+		merged.Mode = defaults.Mode
+	}
+	if merged.Repository == "" {
+		merged.Repository = defaults.Repository
+	}
+	if merged.Path == "" {
+		merged.Path = defaults.Path
+	}
+	if merged.License == "" {
+		merged.License = defaults.License
+	}
+	if merged.Description == "" {
+		merged.Description = defaults.Description
+	}
+	return &merged
+}
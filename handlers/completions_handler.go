@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/detection"
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+type cliCommandsMsg struct {
+	commands detection.CLICommands
+}
+
+// detectCLICommandsCmd builds the CLI in the background to see which generator commands it has
+func (m *ConfigureModel) detectCLICommandsCmd() tea.Cmd {
+	if m.DetectedProject == nil || m.DetectedProject.Path == "" || detection.DetectCLIFramework(m.DetectedProject.Path) == "" {
+		return nil
+	}
+	path, binary := m.DetectedProject.Path, generator.ProjectName(m.DetectedProject)
+	return func() tea.Msg {
+		return cliCommandsMsg{commands: detection.DetectCLICommands(path, binary)}
+	}
+}
+
+// applyCLICommands records the main package, turns off what the CLI can't generate
+// and offers the rest in the Build tab
+func (m *ConfigureModel) applyCLICommands(commands detection.CLICommands) {
+	m.CLICommands = &commands
+
+	if m.ProjectConfig != nil && m.ProjectConfig.Config != nil && m.ProjectConfig.Config.Completions != nil {
+		completions := m.ProjectConfig.Config.Completions
+		changed := completions.Main != commands.Main
+		completions.Main = commands.Main
+		if completions.Enabled && !commands.Completion {
+			completions.Enabled, changed = false, true
+		}
+		if completions.ManPages && !commands.Man {
+			completions.ManPages, changed = false, true
+		}
+		if changed {
+			m.saveConfig()
+		}
+	}
+	m.refreshCompletionItems()
+}
+
+// refreshCompletionItems replaces the completion and man page items of the Build tab
+func (m *ConfigureModel) refreshCompletionItems() {
+	var items []list.Item
+	for _, item := range m.Lists[2].Items() {
+		if build, ok := item.(BuildItem); ok && (build.Key == "completions" || build.Key == "manpages") {
+			continue
+		}
+		items = append(items, item)
+	}
+	m.Lists[2].SetItems(append(items, m.completionItems()...))
+}
+
+func (m *ConfigureModel) completionItems() []list.Item {
+	if m.CLICommands == nil || m.DetectedProject == nil {
+		return nil
+	}
+	var completions models.CompletionSettings
+	if m.ProjectConfig != nil && m.ProjectConfig.Config != nil && m.ProjectConfig.Config.Completions != nil {
+		completions = *m.ProjectConfig.Config.Completions
+	}
+	framework := detection.DetectCLIFramework(m.DetectedProject.Path)
+
+	var items []list.Item
+	if m.CLICommands.Completion {
+		items = append(items, BuildItem{Name: "Ship shell completions", Key: "completions", Enabled: completions.Enabled,
+			Value: fmt.Sprintf("%s • go run %s completion bash|zsh|fish", framework, m.CLICommands.Main)})
+	}
+	if m.CLICommands.Man {
+		items = append(items, BuildItem{Name: "Ship man pages", Key: "manpages", Enabled: completions.ManPages,
+			Value: fmt.Sprintf("go run %s man", m.CLICommands.Main)})
+	}
+	return items
+}
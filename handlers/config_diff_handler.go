@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/goreleaser"
+	"distui/internal/models"
+	"distui/internal/textdiff"
+)
+
+type FileAction string
+
+const (
+	FileCreate FileAction = "create"
+	FileUpdate FileAction = "update"
+	FileDelete FileAction = "delete"
+	FileMerge  FileAction = "merge"
+)
+
+// FileDiff is a pending change to one release file
+type FileDiff struct {
+	Name     string // Path relative to the project
+	Action   FileAction
+	Old      string
+	New      string
+	Accepted bool
+	Issues   []goreleaser.Issue // Schema issues in New, goreleaser configs only
+	Notes    []string           // What was changed and why, shown under the diff
+}
+
+// ConfigDiffModel previews regenerated release files before anything is written
+type ConfigDiffModel struct {
+	Files     []FileDiff
+	Selected  int
+	Offset    int // Scroll offset in the selected file's diff
+	Error     string
+	Width     int
+	Height    int
+	Title     string
+	Migration bool // Rewrites deprecated keys, doesn't touch regeneration state
+}
+
+// BuildConfigDiffs renders every pending file and compares it with what is on disk.
+// Files whose content would not change are left out.
+func BuildConfigDiffs(detectedProject *models.ProjectInfo, projectConfig *models.ProjectConfig, filesToGenerate, filesToDelete []string) ([]FileDiff, error) {
+	if detectedProject == nil || projectConfig == nil {
+		return nil, nil
+	}
+
+	var diffs []FileDiff
+
+	for _, fileName := range filesToGenerate {
+		content, err := RenderConfigFile(detectedProject, projectConfig, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s: %w", fileName, err)
+		}
+
+		action := FileCreate
+		existing, err := os.ReadFile(filepath.Join(detectedProject.Path, fileName))
+		if err == nil {
+			action = FileUpdate
+		}
+		if string(existing) == content {
+			continue
+		}
+
+		diffs = append(diffs, FileDiff{Name: fileName, Action: action, Old: string(existing), New: content, Accepted: true})
+	}
+
+	for _, fileName := range filesToDelete {
+		existing, err := os.ReadFile(filepath.Join(detectedProject.Path, fileName))
+		if err != nil {
+			continue
+		}
+		diffs = append(diffs, FileDiff{Name: fileName, Action: FileDelete, Old: string(existing), Accepted: true})
+	}
+
+	// Custom goreleaser with merge enabled
+	if projectConfig.CustomFilesMode && projectConfig.MergeCustomGoReleaser {
+		merge, err := PrepareGoReleaserMerge(detectedProject, projectConfig)
+		if err != nil {
+			return nil, err
+		}
+		if merge.Old != merge.New {
+			diffs = append([]FileDiff{*merge}, diffs...)
+		}
+	}
+
+	for i := range diffs {
+		if diffs[i].Action != FileDelete && isGoReleaserConfig(diffs[i].Name) {
+			diffs[i].Issues, _ = goreleaser.Validate([]byte(diffs[i].New))
+		}
+	}
+
+	return diffs, nil
+}
+
+func isGoReleaserConfig(name string) bool {
+	switch filepath.Base(name) {
+	case ".goreleaser.yaml", ".goreleaser.yml", "goreleaser.yaml", "goreleaser.yml":
+		return true
+	}
+	return false
+}
+
+// ApplyConfigDiffs writes or deletes every accepted file exactly as previewed
+func ApplyConfigDiffs(projectPath string, diffs []FileDiff) error {
+	for _, diff := range diffs {
+		if !diff.Accepted {
+			continue
+		}
+
+		fullPath := filepath.Join(projectPath, diff.Name)
+		if diff.Action == FileDelete {
+			if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("deleting %s: %w", diff.Name, err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			return fmt.Errorf("creating directory for %s: %w", diff.Name, err)
+		}
+		if err := os.WriteFile(fullPath, []byte(diff.New), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", diff.Name, err)
+		}
+	}
+	return nil
+}
+
+func applyConfigDiffsCmd(projectPath string, diffs []FileDiff) tea.Cmd {
+	return func() tea.Msg {
+		return filesGeneratedMsg{err: ApplyConfigDiffs(projectPath, diffs)}
+	}
+}
+
+func NewConfigDiffModel(diffs []FileDiff, width, height int) *ConfigDiffModel {
+	return &ConfigDiffModel{
+		Files:  diffs,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// AcceptedCount returns how many files will be written
+func (m *ConfigDiffModel) AcceptedCount() int {
+	count := 0
+	for _, f := range m.Files {
+		if f.Accepted {
+			count++
+		}
+	}
+	return count
+}
+
+// Current returns the selected file diff, or nil if there are none
+func (m *ConfigDiffModel) Current() *FileDiff {
+	if m == nil || m.Selected < 0 || m.Selected >= len(m.Files) {
+		return nil
+	}
+	return &m.Files[m.Selected]
+}
+
+func (m *ConfigDiffModel) Update(msg tea.KeyMsg) *ConfigDiffModel {
+	if len(m.Files) == 0 {
+		return m
+	}
+
+	switch msg.String() {
+	case "tab", "right", "l":
+		m.Selected = (m.Selected + 1) % len(m.Files)
+		m.Offset = 0
+	case "shift+tab", "left", "h":
+		m.Selected = (m.Selected + len(m.Files) - 1) % len(m.Files)
+		m.Offset = 0
+	case " ", "space":
+		m.Files[m.Selected].Accepted = !m.Files[m.Selected].Accepted
+	case "a":
+		allAccepted := m.AcceptedCount() == len(m.Files)
+		for i := range m.Files {
+			m.Files[i].Accepted = !allAccepted
+		}
+	case "j", "down":
+		if m.Offset < m.diffLineCount()-1 {
+			m.Offset++
+		}
+	case "k", "up":
+		if m.Offset > 0 {
+			m.Offset--
+		}
+	case "pgdown":
+		m.Offset += 10
+		if max := m.diffLineCount() - 1; m.Offset > max {
+			m.Offset = max
+		}
+		if m.Offset < 0 {
+			m.Offset = 0
+		}
+	case "pgup":
+		m.Offset -= 10
+		if m.Offset < 0 {
+			m.Offset = 0
+		}
+	}
+	return m
+}
+
+// diffLineCount is the number of rendered lines (hunk headers included) for the selected file
+func (m *ConfigDiffModel) diffLineCount() int {
+	current := m.Current()
+	if current == nil {
+		return 0
+	}
+	count := 0
+	for _, hunk := range textdiff.Hunks(current.Old, current.New, 3) {
+		count += 1 + len(hunk.Lines)
+	}
+	return count
+}
@@ -7,7 +7,10 @@ import (
 
 	"distui/internal/detection"
 	"distui/internal/generator"
+	"distui/internal/gomod"
+	"distui/internal/goreleaser"
 	"distui/internal/models"
+	"distui/internal/workflow"
 )
 
 func CheckMissingConfigFiles(detectedProject *models.ProjectInfo, projectConfig *models.ProjectConfig) []string {
@@ -61,27 +64,64 @@ func GenerateConfigFiles(detectedProject *models.ProjectInfo, projectConfig *mod
 			return fmt.Errorf("%s exists and appears to be custom. distui will use it as-is for releases. To regenerate, delete it first or use 'Force Regenerate'", fileName)
 		}
 
-		if fileName == ".goreleaser.yaml" {
-			content, err := generator.GenerateGoReleaserConfig(detectedProject, projectConfig)
-			if err != nil {
-				return err
-			}
-			// Safe to overwrite - file doesn't exist or is distui-generated
-			if err := generator.WriteGoReleaserConfigForce(detectedProject.Path, content); err != nil {
-				return err
-			}
-		} else if fileName == "package.json" {
-			content, err := generator.GeneratePackageJSON(detectedProject, projectConfig)
-			if err != nil {
-				return err
-			}
-			// Safe to overwrite - file doesn't exist or is distui-generated
-			if err := generator.WritePackageJSONForce(detectedProject.Path, content); err != nil {
-				return err
-			}
+		content, err := RenderConfigFile(detectedProject, projectConfig, fileName)
+		if err != nil {
+			return err
+		}
+
+		// Safe to overwrite - file doesn't exist or is distui-generated
+		if err := writeConfigFile(detectedProject.Path, fileName, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderConfigFile returns the content distui would generate for fileName
+func RenderConfigFile(detectedProject *models.ProjectInfo, projectConfig *models.ProjectConfig, fileName string) (string, error) {
+	switch fileName {
+	case ".goreleaser.yaml":
+		return generator.GenerateGoReleaserConfig(detectedProject, projectConfig)
+	case "package.json":
+		return generator.GeneratePackageJSON(detectedProject, projectConfig)
+	case workflow.WorkflowPath(projectConfig):
+		return workflow.GenerateWorkflow(projectConfig)
+	case generator.DockerfilePath(projectConfig):
+		docker := projectConfig.Config.Distributions.Docker
+		if docker == nil {
+			return "", fmt.Errorf("container image settings missing")
+		}
+		return generator.GenerateDockerfile(detectedProject, docker)
+	case generator.FlakeFile:
+		nix := projectConfig.Config.Distributions.Nix
+		if nix == nil {
+			return "", fmt.Errorf("nix settings missing")
+		}
+		vendorHash, err := gomod.VendorHash(detectedProject.Path)
+		if err != nil {
+			return "", fmt.Errorf("computing vendorHash: %w", err)
 		}
+		return generator.GenerateFlake(detectedProject, nix, vendorHash, generator.CompletionSettingsFor(projectConfig))
+	}
+	return "", fmt.Errorf("unknown config file: %s", fileName)
+}
+
+func writeConfigFile(projectPath, fileName, content string) error {
+	switch fileName {
+	case ".goreleaser.yaml":
+		return generator.WriteGoReleaserConfigForce(projectPath, content)
+	case "package.json":
+		return generator.WritePackageJSONForce(projectPath, content)
 	}
 
+	fullPath := filepath.Join(projectPath, fileName)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", fileName, err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", fileName, err)
+	}
 	return nil
 }
 
@@ -194,5 +234,174 @@ func GetConfigFileChanges(detectedProject *models.ProjectInfo, projectConfig *mo
 		changes.FilesToDelete = append(changes.FilesToDelete, "package.json")
 	}
 
+	// Release workflow, only touched when distui generated it
+	workflowFile := workflow.WorkflowPath(projectConfig)
+	workflowPath := filepath.Join(projectPath, workflowFile)
+	if detection.IsCustomConfig(workflowPath) {
+		// Custom workflow - leave it alone
+	} else if workflow.IsEnabled(projectConfig) {
+		changes.FilesToGenerate = append(changes.FilesToGenerate, workflowFile)
+	} else if detection.FileExists(workflowPath) {
+		changes.FilesToDelete = append(changes.FilesToDelete, workflowFile)
+	}
+
+	// Dockerfile for container images, a project's own Dockerfile is never touched
+	dockerfile := generator.DockerfilePath(projectConfig)
+	dockerfilePath := filepath.Join(projectPath, dockerfile)
+	dockerEnabled := projectConfig.Config != nil && projectConfig.Config.Distributions.Docker != nil &&
+		projectConfig.Config.Distributions.Docker.Enabled
+	if detection.IsCustomConfig(dockerfilePath) {
+		// Custom Dockerfile - leave it alone
+	} else if dockerEnabled {
+		changes.FilesToGenerate = append(changes.FilesToGenerate, dockerfile)
+	} else if detection.FileExists(dockerfilePath) {
+		changes.FilesToDelete = append(changes.FilesToDelete, dockerfile)
+	}
+
+	// flake.nix in flake mode, the NUR mode derivation lives in the NUR repository
+	flakePath := filepath.Join(projectPath, generator.FlakeFile)
+	flakeEnabled := projectConfig.Config != nil && projectConfig.Config.Distributions.Nix != nil &&
+		projectConfig.Config.Distributions.Nix.Enabled && projectConfig.Config.Distributions.Nix.Mode == "flake"
+	if detection.IsCustomConfig(flakePath) {
+		// Custom flake - leave it alone
+	} else if flakeEnabled {
+		changes.FilesToGenerate = append(changes.FilesToGenerate, generator.FlakeFile)
+	} else if detection.FileExists(flakePath) {
+		changes.FilesToDelete = append(changes.FilesToDelete, generator.FlakeFile)
+	}
+
 	return changes
-}
\ No newline at end of file
+}
+
+// PrepareGoReleaserMerge renders the managed sections and merges them into the
+// existing custom goreleaser config without writing anything.
+func PrepareGoReleaserMerge(detectedProject *models.ProjectInfo, projectConfig *models.ProjectConfig) (*FileDiff, error) {
+	if detectedProject == nil || projectConfig == nil {
+		return nil, fmt.Errorf("project and config required")
+	}
+
+	generated, err := generator.GenerateGoReleaserConfig(detectedProject, projectConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	path, existing, merged, err := generator.MergeGoReleaserConfigFile(detectedProject.Path, generated)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(detectedProject.Path, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+
+	return &FileDiff{Name: rel, Action: FileMerge, Old: existing, New: merged, Accepted: true}, nil
+}
+
+// PrepareGoReleaserMigration rewrites deprecated keys in the project's goreleaser
+// config. Nothing is written, the caller shows the diff first.
+func PrepareGoReleaserMigration(projectPath string) (*FileDiff, error) {
+	path := generator.FindGoReleaserConfig(projectPath)
+	if path == "" {
+		return nil, fmt.Errorf("no goreleaser config found")
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading goreleaser config: %w", err)
+	}
+
+	migrated, changes, err := goreleaser.Migrate(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(projectPath, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+
+	diff := &FileDiff{Name: rel, Action: FileUpdate, Old: string(existing), New: migrated, Accepted: true}
+	for _, change := range changes {
+		note := fmt.Sprintf("line %d: %s", change.Line, change.Description)
+		if change.Manual {
+			note = "manual: " + note
+		}
+		diff.Notes = append(diff.Notes, note)
+	}
+	diff.Issues, _ = goreleaser.Validate([]byte(migrated))
+
+	return diff, nil
+}
+
+// EnableGoReleaserMerge turns on merge mode for a custom goreleaser config and
+// seeds the distribution settings from what the file already publishes.
+func EnableGoReleaserMerge(detectedProject *models.ProjectInfo, projectConfig *models.ProjectConfig) error {
+	if detectedProject == nil || projectConfig == nil || projectConfig.Config == nil {
+		return fmt.Errorf("project and config required")
+	}
+
+	existing, err := detection.DetectGoReleaserConfig(detectedProject.Path)
+	if err != nil {
+		return fmt.Errorf("reading goreleaser config: %w", err)
+	}
+
+	if projectConfig.Config.Distributions.GitHubRelease == nil {
+		projectConfig.Config.Distributions.GitHubRelease = &models.GitHubReleaseConfig{}
+	}
+	projectConfig.Config.Distributions.GitHubRelease.Enabled = true
+
+	if existing.HasHomebrew {
+		if projectConfig.Config.Distributions.Homebrew == nil {
+			projectConfig.Config.Distributions.Homebrew = &models.HomebrewConfig{}
+		}
+		projectConfig.Config.Distributions.Homebrew.Enabled = true
+		if existing.HomebrewTap != "" {
+			projectConfig.Config.Distributions.Homebrew.TapRepo = existing.HomebrewTap
+		}
+		if existing.FormulaName != "" {
+			projectConfig.Config.Distributions.Homebrew.FormulaName = existing.FormulaName
+		}
+		// Keep publishing the formula until the user migrates the tap to a cask
+		if !existing.HomebrewCask && projectConfig.Config.Distributions.Homebrew.Package == "" {
+			projectConfig.Config.Distributions.Homebrew.Package = "formula"
+		}
+	}
+
+	if existing.HasScoop {
+		if projectConfig.Config.Distributions.Scoop == nil {
+			projectConfig.Config.Distributions.Scoop = &models.ScoopConfig{}
+		}
+		projectConfig.Config.Distributions.Scoop.Enabled = true
+		if existing.ScoopBucket != "" {
+			projectConfig.Config.Distributions.Scoop.BucketRepo = existing.ScoopBucket
+		}
+		if existing.ScoopName != "" {
+			projectConfig.Config.Distributions.Scoop.ManifestName = existing.ScoopName
+		}
+	}
+
+	if existing.Winget != nil {
+		projectConfig.Config.Distributions.Winget = existing.Winget
+	}
+	if existing.AUR != nil {
+		existing.AUR.PrivateKeyPath = generator.DefaultAURKeyPath
+		projectConfig.Config.Distributions.AUR = existing.AUR
+	}
+	if existing.NFPM != nil {
+		projectConfig.Config.Distributions.NFPM = existing.NFPM
+	}
+	if existing.Docker != nil {
+		projectConfig.Config.Distributions.Docker = existing.Docker
+		if projectConfig.Config.Distributions.Docker.Dockerfile == "" {
+			projectConfig.Config.Distributions.Docker.Dockerfile = "Dockerfile"
+		}
+		projectConfig.Config.Distributions.Docker.BaseImage = "distroless"
+	}
+	if existing.Nix != nil {
+		projectConfig.Config.Distributions.Nix = existing.Nix
+	}
+
+	projectConfig.MergeCustomGoReleaser = true
+	return nil
+}
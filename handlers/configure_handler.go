@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 	"os/exec"
+	"path/filepath"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -12,7 +13,10 @@ import (
 
 	"distui/internal/config"
 	"distui/internal/detection"
+	"distui/internal/executor"
+	"distui/internal/generator"
 	"distui/internal/gitcleanup"
+	"distui/internal/goreleaser"
 	"distui/internal/models"
 )
 
@@ -58,6 +62,37 @@ type distributionDetectedMsg struct {
 	npmFromFile       bool // Detected from package.json
 }
 
+type publishedVersionMsg struct {
+	key    string
+	status string
+}
+
+// checkAURVersionCmd looks up what the AUR currently has for the package
+func checkAURVersionCmd(packageName string) tea.Cmd {
+	return func() tea.Msg {
+		info, err := detection.VerifyAURPackage(packageName)
+		switch {
+		case err != nil:
+			return publishedVersionMsg{key: "aur", status: "AUR unreachable"}
+		case !info.Exists:
+			return publishedVersionMsg{key: "aur", status: "not on AUR yet"}
+		default:
+			return publishedVersionMsg{key: "aur", status: "AUR " + info.Version}
+		}
+	}
+}
+
+// publishedVersionCmds starts the version lookups for enabled channels that support them
+func (m *ConfigureModel) publishedVersionCmds() []tea.Cmd {
+	var cmds []tea.Cmd
+	if m.ProjectConfig != nil && m.ProjectConfig.Config != nil {
+		if aur := m.ProjectConfig.Config.Distributions.AUR; aur != nil && aur.Enabled && aur.PackageName != "" {
+			cmds = append(cmds, checkAURVersionCmd(aur.PackageName))
+		}
+	}
+	return cmds
+}
+
 type githubStatusMsg struct {
 	hasRemote  bool
 	owner      string
@@ -97,6 +132,11 @@ const (
 	RepoCleanupView
 	FirstTimeSetupView
 	ModeSwitchWarning
+	ConfigIssuesView
+	ArchiveSettingsView
+	ChannelSettingsView
+	PackageCheckView
+	BrewPreviewView
 )
 
 // ConfigureModel holds the state for the configure view
@@ -119,6 +159,8 @@ type ConfigureModel struct {
 	GitHubModel           *GitHubModel
 	CommitModel           *CommitModel
 	SmartCommitPrefsModel *SmartCommitPrefsModel
+	ArchiveModel          *ArchiveSettingsModel
+	ChannelModel          *ChannelSettingsModel
 	RepoCleanupModel      *RepoCleanupModel
 	BranchModal           *BranchSelectionModel
 	FileSelectionModel    *FileSelectionModel
@@ -133,6 +175,26 @@ type ConfigureModel struct {
 	GenerateStatus       string // Status message for generation
 	NeedsRegeneration    bool   // Config changed, files need regeneration
 
+	ConfigDiff           *ConfigDiffModel // Per-file diff preview before writing
+
+	// Versions currently published on channels that report one, by distribution key
+	PublishedVersions map[string]string
+
+	// Commands the built CLI has, nil until checked. Completions and man pages are offered from it.
+	CLICommands *detection.CLICommands
+
+	// Snapshot build check of the Linux packages
+	PackageReports    []executor.PackageReport
+	PackageCheckError string
+
+	// Locally rendered Homebrew formula or cask
+	BrewPreview      *BrewPreview
+	BrewPreviewError string
+
+	// Offline goreleaser config validation
+	ConfigIssues     []goreleaser.Issue
+	ConfigIssuesFile string // Config file the issues refer to
+
 	// Legacy fields (to be removed)
 	CreatingRepo       bool
 	RepoNameInput      textinput.Model
@@ -159,10 +221,6 @@ type ConfigureModel struct {
 	NPMNameSuggestions []string // Alternative names if unavailable
 	NPMNameError       string   // Error message if check failed
 
-	// NPM package name editing
-	NPMEditMode   bool
-	NPMNameInput  textinput.Model
-
 	// First-time setup for existing distributions
 	FirstTimeSetup             bool
 	FirstTimeSetupConfirmation bool   // Show confirmation screen before verifying
@@ -227,6 +285,9 @@ func (i DistributionItem) Description() string {
 	if i.Key == "npm" && i.Status == "unavailable" {
 		return i.Desc + " • Press 'e' to search for another name"
 	}
+	if i.Key != "npm" && i.Status != "" {
+		return i.Desc + " • " + i.Status
+	}
 	return i.Desc
 }
 
@@ -237,9 +298,13 @@ type BuildItem struct {
 	Name    string
 	Value   string
 	Enabled bool
+	Key     string // Identifies settings that don't map to a fixed list position
 }
 
 func (i BuildItem) Title() string {
+	if i.Key == "archive" {
+		return "[e] " + i.Name
+	}
 	if i.Enabled {
 		return "[✓] " + i.Name
 	}
@@ -332,6 +397,97 @@ func (m *ConfigureModel) detectFilesToOverwrite() []string {
 	return files
 }
 
+// openArchiveEditor switches to the archive settings editor
+func (m *ConfigureModel) openArchiveEditor() {
+	// Native npm packages ship the binaries themselves, only the postinstall download cares about archives
+	npmEnabled := m.ProjectConfig != nil && m.ProjectConfig.Config != nil &&
+		m.ProjectConfig.Config.Distributions.NPM != nil && m.ProjectConfig.Config.Distributions.NPM.Enabled &&
+		!generator.NPMIsNative(m.ProjectConfig.Config.Distributions.NPM)
+	m.ArchiveModel = NewArchiveSettingsModel(generator.ArchiveSettingsFor(m.ProjectConfig), m.DetectedProject, npmEnabled, m.Width, m.Height)
+	if m.ProjectConfig != nil && m.ProjectConfig.Config != nil {
+		dists := m.ProjectConfig.Config.Distributions
+		if dists.Scoop != nil && dists.Scoop.Enabled {
+			m.ArchiveModel.WindowsManagers = append(m.ArchiveModel.WindowsManagers, "scoop")
+		}
+		if dists.Winget != nil && dists.Winget.Enabled {
+			m.ArchiveModel.WindowsManagers = append(m.ArchiveModel.WindowsManagers, "winget")
+		}
+	}
+	m.CurrentView = ArchiveSettingsView
+}
+
+// saveArchiveSettings stores the editor result and refreshes the Build tab summary
+func (m *ConfigureModel) saveArchiveSettings() error {
+	if m.ArchiveModel == nil || m.ProjectConfig == nil {
+		return nil
+	}
+	if err := m.ArchiveModel.Validate(); err != nil {
+		return err
+	}
+	if m.ProjectConfig.Config == nil {
+		m.ProjectConfig.Config = &models.ProjectSettings{}
+	}
+
+	settings := m.ArchiveModel.Settings()
+	m.ProjectConfig.Config.Archive = settings
+
+	items := m.Lists[2].Items()
+	for i, item := range items {
+		if build, ok := item.(BuildItem); ok && build.Key == "archive" {
+			build.Value = archiveSummary(settings)
+			items[i] = build
+		}
+	}
+	m.Lists[2].SetItems(items)
+	m.NeedsRegeneration = true
+
+	return config.SaveProject(m.ProjectConfig)
+}
+
+// refreshConfigIssues validates the project's goreleaser config against the embedded schema
+func (m *ConfigureModel) refreshConfigIssues() {
+	m.ConfigIssues = nil
+	m.ConfigIssuesFile = ""
+	if m.DetectedProject == nil {
+		return
+	}
+
+	path := generator.FindGoReleaserConfig(m.DetectedProject.Path)
+	if path == "" {
+		return
+	}
+
+	issues, err := goreleaser.ValidateFile(path)
+	if err != nil {
+		return
+	}
+	m.ConfigIssues = issues
+	m.ConfigIssuesFile = filepath.Base(path)
+}
+
+// defaultScoopBucket picks a local bucket checkout if there is one, otherwise owner/scoop-bucket
+func (m *ConfigureModel) defaultScoopBucket() string {
+	owner := ""
+	if m.DetectedProject != nil && m.DetectedProject.Repository != nil {
+		owner = m.DetectedProject.Repository.Owner
+	}
+	if owner == "" && m.GlobalConfig != nil {
+		owner = m.GlobalConfig.User.GitHubUsername
+	}
+	if owner == "" {
+		return ""
+	}
+
+	bucket, err := detection.DetectScoopBucket(owner)
+	if err != nil {
+		return owner + "/scoop-bucket"
+	}
+	if bucket.Exists && m.ProjectConfig.Config.Distributions.Scoop.BucketPath == "" {
+		m.ProjectConfig.Config.Distributions.Scoop.BucketPath = bucket.Path
+	}
+	return bucket.BucketRepo(owner)
+}
+
 func (m *ConfigureModel) saveConfig() error {
 	return m.saveConfigWithRegenFlag(true)
 }
@@ -360,6 +516,9 @@ func (m *ConfigureModel) saveConfigWithRegenFlag(needsRegen bool) error {
 				if m.ProjectConfig.Config.Distributions.Homebrew == nil {
 					m.ProjectConfig.Config.Distributions.Homebrew = &models.HomebrewConfig{}
 				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.Homebrew = mergeHomebrewDefaults(m.ProjectConfig.Config.Distributions.Homebrew, m.defaultHomebrewConfig)
+				}
 				m.ProjectConfig.Config.Distributions.Homebrew.Enabled = dist.Enabled
 			case "npm":
 				if m.ProjectConfig.Config.Distributions.NPM == nil {
@@ -371,6 +530,54 @@ func (m *ConfigureModel) saveConfigWithRegenFlag(needsRegen bool) error {
 					m.ProjectConfig.Config.Distributions.GoModule = &models.GoModuleConfig{}
 				}
 				m.ProjectConfig.Config.Distributions.GoModule.Enabled = dist.Enabled
+			case "scoop":
+				if m.ProjectConfig.Config.Distributions.Scoop == nil {
+					m.ProjectConfig.Config.Distributions.Scoop = &models.ScoopConfig{}
+				}
+				m.ProjectConfig.Config.Distributions.Scoop.Enabled = dist.Enabled
+				if dist.Enabled && m.ProjectConfig.Config.Distributions.Scoop.BucketRepo == "" {
+					m.ProjectConfig.Config.Distributions.Scoop.BucketRepo = m.defaultScoopBucket()
+				}
+			case "winget":
+				if m.ProjectConfig.Config.Distributions.Winget == nil {
+					m.ProjectConfig.Config.Distributions.Winget = &models.WingetConfig{}
+				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.Winget = mergeWingetDefaults(m.ProjectConfig.Config.Distributions.Winget, generator.DefaultWingetConfig(m.DetectedProject))
+				}
+				m.ProjectConfig.Config.Distributions.Winget.Enabled = dist.Enabled
+			case "aur":
+				if m.ProjectConfig.Config.Distributions.AUR == nil {
+					m.ProjectConfig.Config.Distributions.AUR = &models.AURConfig{}
+				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.AUR = mergeAURDefaults(m.ProjectConfig.Config.Distributions.AUR, m.defaultAURConfig)
+				}
+				m.ProjectConfig.Config.Distributions.AUR.Enabled = dist.Enabled
+			case "nfpm":
+				if m.ProjectConfig.Config.Distributions.NFPM == nil {
+					m.ProjectConfig.Config.Distributions.NFPM = &models.NFPMConfig{}
+				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.NFPM = mergeNFPMDefaults(m.ProjectConfig.Config.Distributions.NFPM, m.defaultNFPMConfig)
+				}
+				m.ProjectConfig.Config.Distributions.NFPM.Enabled = dist.Enabled
+			case "docker":
+				if m.ProjectConfig.Config.Distributions.Docker == nil {
+					m.ProjectConfig.Config.Distributions.Docker = &models.DockerConfig{}
+				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.Docker = mergeDockerDefaults(m.ProjectConfig.Config.Distributions.Docker, m.defaultDockerConfig)
+				}
+				m.ProjectConfig.Config.Distributions.Docker.Enabled = dist.Enabled
+			case "nix":
+				if m.ProjectConfig.Config.Distributions.Nix == nil {
+					m.ProjectConfig.Config.Distributions.Nix = &models.NixConfig{}
+				}
+				if dist.Enabled {
+					m.ProjectConfig.Config.Distributions.Nix = mergeNixDefaults(m.ProjectConfig.Config.Distributions.Nix, generator.DefaultNixConfig(m.DetectedProject))
+				}
+				m.ProjectConfig.Config.Distributions.Nix.Enabled = dist.Enabled
 			}
 		}
 	}
@@ -385,6 +592,23 @@ func (m *ConfigureModel) saveConfigWithRegenFlag(needsRegen bool) error {
 				}
 				m.ProjectConfig.Config.Release.SkipTests = !build.Enabled
 			}
+			switch build.Key {
+			case "completions", "manpages":
+				if m.ProjectConfig.Config.Completions == nil {
+					m.ProjectConfig.Config.Completions = &models.CompletionSettings{}
+				}
+				if m.DetectedProject != nil {
+					m.ProjectConfig.Config.Completions.Framework = detection.DetectCLIFramework(m.DetectedProject.Path)
+				}
+				if m.CLICommands != nil {
+					m.ProjectConfig.Config.Completions.Main = m.CLICommands.Main
+				}
+				if build.Key == "completions" {
+					m.ProjectConfig.Config.Completions.Enabled = build.Enabled
+				} else {
+					m.ProjectConfig.Config.Completions.ManPages = build.Enabled
+				}
+			}
 		}
 	}
 
@@ -486,13 +710,6 @@ func NewConfigureModel(width, height int, githubAccounts []models.GitHubAccount,
 	s.Spinner = spinner.MiniDot
 	m.CreateSpinner = s
 
-	// Initialize NPM package name input
-	npmInput := textinput.New()
-	npmInput.Placeholder = "package-name"
-	npmInput.CharLimit = 214 // npm package name limit
-	npmInput.Width = width - 8
-	m.NPMNameInput = npmInput
-
 	// Calculate list height - account for ALL chrome that's NOT part of the list
 	// The list will be rendered INSIDE a content box, so we need to account for:
 	// App wrapper (app.go): 4 lines (border 2 + padding 2)
@@ -565,6 +782,7 @@ func NewConfigureModel(width, height int, githubAccounts []models.GitHubAccount,
 		BuildItem{Name: "Clean build directory", Value: "", Enabled: cleanBuild},
 		BuildItem{Name: "Build for all platforms", Value: "darwin, linux, windows", Enabled: allPlatforms},
 		BuildItem{Name: "Include ARM64 builds", Value: "", Enabled: arm64Builds},
+		BuildItem{Name: "Archive contents & naming", Value: archiveSummary(generator.ArchiveSettingsFor(projectConfig)), Key: "archive"},
 	}
 
 	buildList := list.New(buildItems, list.NewDefaultDelegate(), listWidth, listHeight)
@@ -648,7 +866,7 @@ func RefreshGitHubStatusCmd() tea.Cmd {
 	return func() tea.Msg {
 		msg := githubStatusMsg{}
 
-		if gitcleanup.HasGitRepo() && gitcleanup.HasGitHubRemote() {
+		if gitcleanup.HasGitRepo() && gitcleanup.HasForgeRemote() {
 			msg.hasRemote = true
 			owner, repo, err := gitcleanup.GetRepoInfo()
 			if err == nil {
@@ -710,7 +928,7 @@ func regularCommitCmd(files []string, message string) tea.Cmd {
 
 // refreshGitHubStatus updates cached GitHub repo status
 func (m *ConfigureModel) refreshGitHubStatus() {
-	if gitcleanup.HasGitRepo() && gitcleanup.HasGitHubRemote() {
+	if gitcleanup.HasGitRepo() && gitcleanup.HasForgeRemote() {
 		m.HasGitRemote = true
 		owner, repo, err := gitcleanup.GetRepoInfo()
 		if err == nil {
@@ -740,7 +958,7 @@ func (m *ConfigureModel) loadGitStatus() []list.Item {
 			})
 		} else if !m.GitHubRepoExists && m.GitHubOwner != "" && m.GitHubRepo != "" {
 			items = append(items, CleanupItem{
-				Path:     fmt.Sprintf("Push to github.com/%s/%s", m.GitHubOwner, m.GitHubRepo),
+				Path:     fmt.Sprintf("Push to %s/%s", m.GitHubOwner, m.GitHubRepo),
 				Status:   "↑",
 				Category: "github-push",
 				Action:   "skip",
@@ -5,7 +5,6 @@ import (
 	"path/filepath"
 	"time"
 
-	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -41,18 +40,36 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 		m.Initialized = true
 		m.CleanupModel = msg.cleanupModel
 		m.Lists[0].SetItems(m.loadGitStatus())
+		m.refreshConfigIssues()
 
 		// Create project config file if it doesn't exist
 		if m.ProjectConfig != nil && m.ProjectConfig.Project != nil {
 			m.saveConfigWithRegenFlag(false) // Don't trigger regen warning on initial load
 		}
 
+		cmds := m.publishedVersionCmds()
+		if cmd := m.detectCLICommandsCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 		// Start background git watcher (polls every 2 seconds)
 		if !m.GitWatcherActive {
 			m.GitWatcherActive = true
-			return m, StartGitWatcherCmd()
+			cmds = append(cmds, StartGitWatcherCmd())
 		}
 
+		return m, tea.Batch(cmds...)
+
+	case cliCommandsMsg:
+		m.applyCLICommands(msg.commands)
+		return m, nil
+
+	case publishedVersionMsg:
+		if m.PublishedVersions == nil {
+			m.PublishedVersions = map[string]string{}
+		}
+		m.PublishedVersions[msg.key] = msg.status
+		m.refreshDistributionsList()
 		return m, nil
 
 	case gitWatchTickMsg:
@@ -113,18 +130,46 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 		return m, tea.Tick(1*time.Second, func(t time.Time) tea.Msg {
 			return struct{}{}
 		})
+	case packageCheckMsg:
+		m.GeneratingFiles = false
+		m.PackageReports = msg.reports
+		m.PackageCheckError = ""
+		if msg.err != nil {
+			m.PackageCheckError = msg.err.Error()
+		}
+		m.CurrentView = PackageCheckView
+		return m, nil
+	case brewPreviewMsg:
+		m.GeneratingFiles = false
+		m.BrewPreview = msg.preview
+		m.BrewPreviewError = ""
+		if msg.err != nil {
+			m.BrewPreviewError = msg.err.Error()
+		}
+		m.CurrentView = BrewPreviewView
+		return m, nil
 	case filesGeneratedMsg:
 		m.GeneratingFiles = false
 		if msg.err == nil {
 			m.GenerateStatus = "✓ Release files updated successfully!"
 			m.CurrentView = TabView
-			m.PendingGenerateFiles = nil
-			m.PendingDeleteFiles = nil
-			m.NeedsRegeneration = false
+			if m.ConfigDiff != nil && m.ConfigDiff.Migration {
+				m.GenerateStatus = "✓ Deprecated keys upgraded"
+			} else {
+				m.PendingGenerateFiles = nil
+				m.PendingDeleteFiles = nil
+				// Rejected files still differ from the current settings
+				m.NeedsRegeneration = m.ConfigDiff != nil && m.ConfigDiff.AcceptedCount() < len(m.ConfigDiff.Files)
+			}
+			m.ConfigDiff = nil
 			// Reload git status to show the newly generated files
 			m.Lists[0].SetItems(m.loadGitStatus())
+			m.refreshConfigIssues()
 		} else {
 			m.GenerateStatus = fmt.Sprintf("✗ Generation failed: %v", msg.err)
+			if m.ConfigDiff != nil {
+				m.ConfigDiff.Error = msg.err.Error()
+			}
 		}
 		// Clear status after 1 second
 		return m, tea.Tick(1*time.Second, func(t time.Time) tea.Msg {
@@ -346,12 +391,7 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 		config.SaveProject(m.ProjectConfig)
 
 		// Rebuild distributions list with updated config
-		distItems := BuildDistributionsList(m.ProjectConfig, m.DetectedProject, m.NPMNameStatus)
-		distributions := make([]list.Item, len(distItems))
-		for i, item := range distItems {
-			distributions[i] = item
-		}
-		m.Lists[1].SetItems(distributions)
+		m.refreshDistributionsList()
 
 		return m, nil
 	case npmNameCheckMsg:
@@ -360,12 +400,7 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 		m.NPMNameSuggestions = msg.result.Suggestions
 
 		// CRITICAL: Rebuild distributions list to show status indicator in NPM item
-		distItems := BuildDistributionsList(m.ProjectConfig, m.DetectedProject, m.NPMNameStatus)
-		distributions := make([]list.Item, len(distItems))
-		for i, item := range distItems {
-			distributions[i] = item
-		}
-		m.Lists[1].SetItems(distributions)
+		m.refreshDistributionsList()
 
 		// CRITICAL: Recalculate list height when NPM status changes
 		// The NPM UI is appended to list content, so list must shrink to make room
@@ -512,6 +547,7 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 
 				// Set managed mode
 				m.ProjectConfig.CustomFilesMode = false
+				m.ProjectConfig.MergeCustomGoReleaser = false
 				m.saveConfig()
 
 				// Generate distui-managed files
@@ -539,53 +575,6 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 			return m.handleFirstTimeSetupKeys(msg)
 		}
 
-		// Handle NPM name editing mode first
-		if m.NPMEditMode {
-			switch msg.String() {
-			case "enter":
-				// Save the new package name
-				newName := m.NPMNameInput.Value()
-				if newName != "" {
-					if m.ProjectConfig.Config.Distributions.NPM == nil {
-						m.ProjectConfig.Config.Distributions.NPM = &models.NPMConfig{}
-					}
-					m.ProjectConfig.Config.Distributions.NPM.PackageName = newName
-					m.saveConfig()
-
-					// Trigger name check first (before rebuilding list)
-					username := ""
-					if m.DetectedProject != nil && m.DetectedProject.Repository != nil {
-						username = m.DetectedProject.Repository.Owner
-					}
-					m.NPMNameStatus = "checking"
-
-					// Rebuild distributions list with new package name and checking status
-					distItems := BuildDistributionsList(m.ProjectConfig, m.DetectedProject, m.NPMNameStatus)
-					listItems := make([]list.Item, len(distItems))
-					for i, item := range distItems {
-						listItems[i] = item
-					}
-					m.Lists[1].SetItems(listItems)
-					m.NPMEditMode = false
-					m.NPMNameInput.Blur()
-					return m, tea.Batch(m.CreateSpinner.Tick, checkNPMNameCmd(newName, username))
-				}
-				m.NPMEditMode = false
-				m.NPMNameInput.Blur()
-				return m, nil
-			case "esc":
-				// Cancel editing
-				m.NPMEditMode = false
-				m.NPMNameInput.Blur()
-				return m, nil
-			default:
-				// Pass to text input
-				var cmd tea.Cmd
-				m.NPMNameInput, cmd = m.NPMNameInput.Update(msg)
-				return m, cmd
-			}
-		}
-
 		// If we're on the cleanup tab and there are no changes, delegate navigation to the repo browser
 		if m.ActiveTab == 0 && m.CleanupModel != nil && !m.CleanupModel.HasChanges() {
 			// Check if this is a navigation key that should go to the repo browser
@@ -663,7 +652,7 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 
 			if i, ok := selectedItem.(DistributionItem); ok {
 				// If in custom mode, prompt to switch instead of saving
-				if m.ProjectConfig != nil && m.ProjectConfig.CustomFilesMode {
+				if m.ProjectConfig != nil && m.ProjectConfig.CustomFilesMode && !m.ProjectConfig.MergeCustomGoReleaser {
 					// Don't toggle - user needs to switch modes first
 					// Revert the toggle
 					items := currentList.Items()
@@ -684,6 +673,10 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 				// In managed mode, files are always distui-generated, no warning needed
 				m.saveConfig()
 
+				if i.Key == "aur" && i.Enabled {
+					return m, tea.Batch(m.publishedVersionCmds()...)
+				}
+
 				// If NPM was just enabled, trigger name check immediately
 				if i.Key == "npm" && i.Enabled {
 					packageName := ""
@@ -714,6 +707,10 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 					m.NPMNameSuggestions = nil
 				}
 			} else if i, ok := currentList.SelectedItem().(BuildItem); ok {
+				if i.Key == "archive" {
+					m.openArchiveEditor()
+					return m, nil
+				}
 				i.Enabled = !i.Enabled
 				items := currentList.Items()
 				items[currentList.Index()] = i
@@ -747,22 +744,29 @@ func (m *ConfigureModel) Update(msg tea.Msg) (*ConfigureModel, tea.Cmd) {
 			}
 			return m, nil
 		case "e":
-			// Edit package name when on NPM item in Distributions tab
 			if m.ActiveTab == 1 {
 				selectedItem := m.Lists[1].SelectedItem()
-				if dist, ok := selectedItem.(DistributionItem); ok && dist.Key == "npm" {
-					// Enter edit mode
-					m.NPMEditMode = true
-					currentName := ""
-					if m.ProjectConfig != nil && m.ProjectConfig.Config != nil &&
-						m.ProjectConfig.Config.Distributions.NPM != nil {
-						currentName = m.ProjectConfig.Config.Distributions.NPM.PackageName
-					}
-					m.NPMNameInput.SetValue(currentName)
-					m.NPMNameInput.Focus()
+				if dist, ok := selectedItem.(DistributionItem); ok && m.openChannelEditor(dist.Key) {
 					return m, nil
 				}
 			}
+			// Open the archive editor from the Build tab
+			if m.ActiveTab == 2 {
+				if build, ok := m.Lists[2].SelectedItem().(BuildItem); ok && build.Key == "archive" {
+					m.openArchiveEditor()
+				}
+			}
+			return m, nil
+		case "t":
+			// Test the Linux packages with a local snapshot build, or preview the Homebrew formula
+			if m.ActiveTab == 1 {
+				if dist, ok := m.Lists[1].SelectedItem().(DistributionItem); ok && dist.Key == "nfpm" {
+					return m, m.startPackageCheck()
+				}
+				if dist, ok := m.Lists[1].SelectedItem().(DistributionItem); ok && dist.Key == "homebrew" {
+					return m, m.startBrewPreview()
+				}
+			}
 			return m, nil
 		case "a":
 			// Check/uncheck all in current tab
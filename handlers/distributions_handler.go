@@ -1,6 +1,11 @@
 package handlers
 
 import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+
+	"distui/internal/generator"
 	"distui/internal/models"
 )
 
@@ -12,12 +17,19 @@ func BuildDistributionsList(projectConfig *models.ProjectConfig, detectedProject
 	}
 
 	// If custom mode, show distui defaults (all disabled)
-	if projectConfig.CustomFilesMode {
+	// unless distui merges its sections into the custom goreleaser file
+	if projectConfig.CustomFilesMode && !projectConfig.MergeCustomGoReleaser {
 		return []DistributionItem{
 			{Name: "GitHub Releases", Desc: "Create GitHub releases with GoReleaser", Enabled: false, Key: "github"},
 			{Name: "Homebrew", Desc: "Publish to Homebrew tap", Enabled: false, Key: "homebrew"},
 			{Name: "NPM", Desc: "Publish to NPM registry", Enabled: false, Key: "npm", Status: npmStatus},
 			{Name: "Go Install", Desc: "Installable via go install", Enabled: false, Key: "go_install"},
+			{Name: "Scoop", Desc: "Publish manifest to Scoop bucket", Enabled: false, Key: "scoop"},
+			{Name: "Winget", Desc: "Open manifest PRs on winget-pkgs", Enabled: false, Key: "winget"},
+			{Name: "AUR", Desc: "Publish to the Arch User Repository", Enabled: false, Key: "aur"},
+			{Name: "Linux Packages", Desc: "Build deb/rpm/apk packages with nFPM", Enabled: false, Key: "nfpm"},
+			{Name: "Container Image", Desc: "Push multi-arch images to ghcr.io", Enabled: false, Key: "docker"},
+			{Name: "Nix", Desc: "Publish to a NUR repository or generate flake.nix", Enabled: false, Key: "nix"},
 		}
 	}
 
@@ -40,7 +52,11 @@ func BuildDistributionsList(projectConfig *models.ProjectConfig, detectedProject
 	if projectConfig.Config.Distributions.Homebrew != nil {
 		homebrewEnabled = projectConfig.Config.Distributions.Homebrew.Enabled
 		if projectConfig.Config.Distributions.Homebrew.TapRepo != "" {
-			homebrewDesc = "Tap: " + projectConfig.Config.Distributions.Homebrew.TapRepo
+			kind := "Cask"
+			if !generator.HomebrewIsCask(projectConfig.Config.Distributions.Homebrew) {
+				kind = "Formula"
+			}
+			homebrewDesc = kind + " in " + projectConfig.Config.Distributions.Homebrew.TapRepo
 		}
 	}
 	items = append(items, DistributionItem{
@@ -60,6 +76,9 @@ func BuildDistributionsList(projectConfig *models.ProjectConfig, detectedProject
 		} else if detectedProject != nil && detectedProject.Binary != nil && detectedProject.Binary.Name != "" {
 			npmDesc = "Package: " + detectedProject.Binary.Name
 		}
+		if generator.NPMIsNative(projectConfig.Config.Distributions.NPM) {
+			npmDesc += " (native binaries)"
+		}
 	}
 	items = append(items, DistributionItem{
 		Name:    "NPM",
@@ -74,6 +93,9 @@ func BuildDistributionsList(projectConfig *models.ProjectConfig, detectedProject
 	goModuleDesc := "Install via go install (automatic with git tags)"
 	if projectConfig.Config.Distributions.GoModule != nil {
 		goModuleEnabled = projectConfig.Config.Distributions.GoModule.Enabled
+		if proxy := projectConfig.Config.Distributions.GoModule.Proxy; proxy != "" {
+			goModuleDesc = "Install via go install, warms " + proxy
+		}
 	}
 	items = append(items, DistributionItem{
 		Name:    "Go Module",
@@ -82,5 +104,116 @@ func BuildDistributionsList(projectConfig *models.ProjectConfig, detectedProject
 		Key:     "go_install",
 	})
 
+	// Scoop
+	scoopEnabled := false
+	scoopDesc := "Publish manifest to Scoop bucket"
+	if projectConfig.Config.Distributions.Scoop != nil {
+		scoopEnabled = projectConfig.Config.Distributions.Scoop.Enabled
+		if projectConfig.Config.Distributions.Scoop.BucketRepo != "" {
+			scoopDesc = "Bucket: " + projectConfig.Config.Distributions.Scoop.BucketRepo
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "Scoop",
+		Desc:    scoopDesc,
+		Enabled: scoopEnabled,
+		Key:     "scoop",
+	})
+
+	// Winget
+	wingetEnabled := false
+	wingetDesc := "Open manifest PRs on winget-pkgs"
+	if projectConfig.Config.Distributions.Winget != nil {
+		wingetEnabled = projectConfig.Config.Distributions.Winget.Enabled
+		if projectConfig.Config.Distributions.Winget.PackageIdentifier != "" {
+			wingetDesc = "Package: " + projectConfig.Config.Distributions.Winget.PackageIdentifier
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "Winget",
+		Desc:    wingetDesc,
+		Enabled: wingetEnabled,
+		Key:     "winget",
+	})
+
+	// AUR
+	aurEnabled := false
+	aurDesc := "Publish to the Arch User Repository"
+	if projectConfig.Config.Distributions.AUR != nil {
+		aurEnabled = projectConfig.Config.Distributions.AUR.Enabled
+		if projectConfig.Config.Distributions.AUR.PackageName != "" {
+			aurDesc = "Package: " + projectConfig.Config.Distributions.AUR.PackageName
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "AUR",
+		Desc:    aurDesc,
+		Enabled: aurEnabled,
+		Key:     "aur",
+	})
+
+	// Linux packages
+	nfpmEnabled := false
+	nfpmDesc := "Build deb/rpm/apk packages with nFPM"
+	if projectConfig.Config.Distributions.NFPM != nil {
+		nfpmEnabled = projectConfig.Config.Distributions.NFPM.Enabled
+		if len(projectConfig.Config.Distributions.NFPM.Formats) > 0 {
+			nfpmDesc = "Formats: " + strings.Join(projectConfig.Config.Distributions.NFPM.Formats, ", ")
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "Linux Packages",
+		Desc:    nfpmDesc,
+		Enabled: nfpmEnabled,
+		Key:     "nfpm",
+	})
+
+	// Container image
+	dockerEnabled := false
+	dockerDesc := "Push multi-arch images to ghcr.io"
+	if projectConfig.Config.Distributions.Docker != nil {
+		dockerEnabled = projectConfig.Config.Distributions.Docker.Enabled
+		if projectConfig.Config.Distributions.Docker.Image != "" {
+			dockerDesc = "Image: " + generator.DockerImage(projectConfig.Config.Distributions.Docker)
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "Container Image",
+		Desc:    dockerDesc,
+		Enabled: dockerEnabled,
+		Key:     "docker",
+	})
+
+	// Nix
+	nixEnabled := false
+	nixDesc := "Publish to a NUR repository or generate flake.nix"
+	if nix := projectConfig.Config.Distributions.Nix; nix != nil {
+		nixEnabled = nix.Enabled
+		if nix.Mode == "flake" {
+			nixDesc = "Flake: " + generator.FlakeFile
+		} else if nix.Repository != "" {
+			nixDesc = "NUR: " + nix.Repository
+		}
+	}
+	items = append(items, DistributionItem{
+		Name:    "Nix",
+		Desc:    nixDesc,
+		Enabled: nixEnabled,
+		Key:     "nix",
+	})
+
 	return items
+}
+
+// refreshDistributionsList rebuilds the Distributions tab and adds the published versions
+func (m *ConfigureModel) refreshDistributionsList() {
+	distItems := BuildDistributionsList(m.ProjectConfig, m.DetectedProject, m.NPMNameStatus)
+	distributions := make([]list.Item, len(distItems))
+	for i, item := range distItems {
+		if version, ok := m.PublishedVersions[item.Key]; ok && item.Key != "npm" {
+			item.Status = version
+		}
+		distributions[i] = item
+	}
+	m.Lists[1].SetItems(distributions)
 }
\ No newline at end of file
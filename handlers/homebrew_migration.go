@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"distui/internal/detection"
+	"distui/internal/executor"
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// formulaMigration returns the migration to run after a cask release when the user
+// agreed to it and the local tap checkout still has the formula, nil otherwise
+func formulaMigration(homebrew *models.HomebrewConfig, project *models.ProjectInfo) *executor.FormulaMigration {
+	if homebrew == nil || !homebrew.MigrateFormula {
+		return nil
+	}
+	return pendingFormulaMigration(homebrew, project)
+}
+
+// pendingFormulaMigration is the formula a cask would replace, whether agreed to or not
+func pendingFormulaMigration(homebrew *models.HomebrewConfig, project *models.ProjectInfo) *executor.FormulaMigration {
+	if homebrew == nil || !homebrew.Enabled || !generator.HomebrewIsCask(homebrew) {
+		return nil
+	}
+	name := generator.BrewName(project)
+	tap := detection.FindTapCheckout(homebrew.TapRepo, homebrew.TapPath)
+	if name == "" || tap == nil || !tap.HasFormula(name) {
+		return nil
+	}
+	return &executor.FormulaMigration{TapPath: tap.Path, TapRepo: homebrew.TapRepo, Name: name}
+}
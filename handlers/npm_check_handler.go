@@ -10,6 +10,20 @@ type npmNameCheckMsg struct {
 	result executor.NPMNameCheckResult
 }
 
+// startNPMNameCheck checks the configured package name against the registry
+func (m *ConfigureModel) startNPMNameCheck() tea.Cmd {
+	if m.ProjectConfig == nil || m.ProjectConfig.Config == nil || m.ProjectConfig.Config.Distributions.NPM == nil {
+		return nil
+	}
+	username := ""
+	if m.DetectedProject != nil && m.DetectedProject.Repository != nil {
+		username = m.DetectedProject.Repository.Owner
+	}
+	m.NPMNameStatus = "checking"
+	m.refreshDistributionsList()
+	return tea.Batch(m.CreateSpinner.Tick, checkNPMNameCmd(m.ProjectConfig.Config.Distributions.NPM.PackageName, username))
+}
+
 func checkNPMNameCmd(packageName, username string) tea.Cmd {
 	return func() tea.Msg {
 		result := executor.CheckNPMName(packageName, username)
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/executor"
+	"distui/internal/models"
+)
+
+type packageCheckMsg struct {
+	reports []executor.PackageReport
+	err     error
+}
+
+// checkLinuxPackagesCmd builds a snapshot and inspects the packages it produced
+func checkLinuxPackagesCmd(projectPath string, project *models.ProjectInfo, config *models.NFPMConfig) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+		reports, err := executor.CheckLinuxPackages(ctx, projectPath, project, config)
+		return packageCheckMsg{reports: reports, err: err}
+	}
+}
+
+// startPackageCheck runs the check for the saved Linux package settings
+func (m *ConfigureModel) startPackageCheck() tea.Cmd {
+	if m.DetectedProject == nil || m.ProjectConfig == nil || m.ProjectConfig.Config == nil {
+		return nil
+	}
+	nfpm := m.ProjectConfig.Config.Distributions.NFPM
+	if nfpm == nil || !nfpm.Enabled {
+		m.CreateStatus = "Enable Linux Packages before testing them"
+		return tea.Tick(2*time.Second, func(t time.Time) tea.Msg { return struct{}{} })
+	}
+	if m.NeedsRegeneration {
+		m.CreateStatus = "Generate release files [R] before testing packages"
+		return tea.Tick(2*time.Second, func(t time.Time) tea.Msg { return struct{}{} })
+	}
+
+	m.GeneratingFiles = true
+	m.GenerateStatus = "Building snapshot packages..."
+	m.PackageReports = nil
+	m.PackageCheckError = ""
+	return tea.Batch(m.CreateSpinner.Tick, checkLinuxPackagesCmd(m.DetectedProject.Path, m.DetectedProject, nfpm))
+}
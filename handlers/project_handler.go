@@ -21,12 +21,20 @@ func UpdateProjectView(currentPage, previousPage int, msg tea.Msg, releaseModel
 				if releaseModel.SelectedVersion > 0 {
 					releaseModel.SelectedVersion--
 				}
+				releaseModel.RefreshModulePathCheck()
 				return currentPage, false, nil, releaseModel
 			case "down", "j":
 				if releaseModel.SelectedVersion < 4 {
 					releaseModel.SelectedVersion++
 				}
+				releaseModel.RefreshModulePathCheck()
 				return currentPage, false, nil, releaseModel
+			case "m":
+				// Toggle go.mod /vN rewrite when a major bump needs it
+				if releaseModel.ModulePathCheck != nil {
+					releaseModel.UpdateModulePath = !releaseModel.UpdateModulePath
+					return currentPage, false, nil, releaseModel
+				}
 			case "enter":
 				// Check if "Configure Project" is selected (item 0)
 				if releaseModel.SelectedVersion == 0 {
@@ -44,6 +52,7 @@ func UpdateProjectView(currentPage, previousPage int, msg tea.Msg, releaseModel
 			if releaseModel.SelectedVersion == 4 {
 				var cmd tea.Cmd
 				releaseModel.VersionInput, cmd = releaseModel.VersionInput.Update(msg)
+				releaseModel.RefreshModulePathCheck()
 				return currentPage, false, cmd, releaseModel
 			}
 		}
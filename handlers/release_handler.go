@@ -13,7 +13,10 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"distui/internal/executor"
+	"distui/internal/forge"
+	"distui/internal/generator"
 	"distui/internal/gitcleanup"
+	"distui/internal/gomod"
 	"distui/internal/models"
 )
 
@@ -40,9 +43,16 @@ type ReleaseModel struct {
 	ProjectName string
 	RepoOwner   string
 	RepoName    string
+	Repo        forge.Remote // where the release page lives
 
 	EnableHomebrew bool
 	EnableNPM      bool
+	EnableScoop    bool
+	Winget         *models.WingetConfig // nil unless winget is enabled
+	AUR            *models.AURConfig    // nil unless aur is enabled
+	Docker         *models.DockerConfig // nil unless container images are enabled
+	Nix            *models.NixConfig    // nil unless nix is enabled
+	GoModule       *models.GoModuleConfig // nil unless the go module channel is enabled
 	HomebrewTap    string
 	SkipTests      bool  // From config: Run tests before release
 
@@ -52,6 +62,18 @@ type ReleaseModel struct {
 	// Project config to check settings at runtime
 	ProjectConfig *models.ProjectConfig
 
+	// Links for the release summary (manifest PRs, ...)
+	Links []string
+
+	// Post-release verification, one check per channel
+	Verifying    bool
+	Verification []models.ChannelCheck
+	released     *executor.ReleaseConfig // the last release, kept to verify it again
+
+	// Major version module path handling
+	ModulePathCheck  *gomod.PathCheck // Set when the selected version needs a /vN module path
+	UpdateModulePath bool             // Rewrite go.mod and imports before tagging
+
 	// Channel for receiving output
 	outputChan chan string
 }
@@ -93,6 +115,12 @@ func NewReleaseModel(width, height int, projectPath, projectName, currentVersion
 		progress.WithoutPercentage(),
 	)
 
+	var project *models.ProjectInfo
+	if projectConfig != nil {
+		project = projectConfig.Project
+	}
+	repo := forge.Remote{Kind: generator.ProjectForge(project), Host: generator.ProjectHost(project), Owner: repoOwner, Name: repoName}
+
 	ti := textinput.New()
 	ti.Placeholder = "v0.1.0"
 	ti.CharLimit = 20
@@ -107,6 +135,12 @@ func NewReleaseModel(width, height int, projectPath, projectName, currentVersion
 	// Load config settings
 	enableHomebrew := false
 	enableNPM := false
+	enableScoop := false
+	var winget *models.WingetConfig
+	var aur *models.AURConfig
+	var docker *models.DockerConfig
+	var nix *models.NixConfig
+	var goModule *models.GoModuleConfig
 	homebrewTap := ""
 	skipTests := false  // Default: run tests
 
@@ -118,6 +152,24 @@ func NewReleaseModel(width, height int, projectPath, projectName, currentVersion
 		if projectConfig.Config.Distributions.NPM != nil {
 			enableNPM = projectConfig.Config.Distributions.NPM.Enabled
 		}
+		if projectConfig.Config.Distributions.Scoop != nil {
+			enableScoop = projectConfig.Config.Distributions.Scoop.Enabled
+		}
+		if projectConfig.Config.Distributions.Winget != nil && projectConfig.Config.Distributions.Winget.Enabled {
+			winget = projectConfig.Config.Distributions.Winget
+		}
+		if projectConfig.Config.Distributions.AUR != nil && projectConfig.Config.Distributions.AUR.Enabled {
+			aur = projectConfig.Config.Distributions.AUR
+		}
+		if projectConfig.Config.Distributions.Docker != nil && projectConfig.Config.Distributions.Docker.Enabled {
+			docker = projectConfig.Config.Distributions.Docker
+		}
+		if projectConfig.Config.Distributions.Nix != nil && projectConfig.Config.Distributions.Nix.Enabled {
+			nix = projectConfig.Config.Distributions.Nix
+		}
+		if projectConfig.Config.Distributions.GoModule != nil && projectConfig.Config.Distributions.GoModule.Enabled {
+			goModule = projectConfig.Config.Distributions.GoModule
+		}
 		if projectConfig.Config.Release != nil {
 			skipTests = projectConfig.Config.Release.SkipTests
 		}
@@ -147,8 +199,15 @@ func NewReleaseModel(width, height int, projectPath, projectName, currentVersion
 		ProjectName:     projectName,
 		RepoOwner:       repoOwner,
 		RepoName:        repoName,
+		Repo:            repo,
 		EnableHomebrew:    enableHomebrew,
 		EnableNPM:         enableNPM,
+		EnableScoop:       enableScoop,
+		Winget:            winget,
+		AUR:               aur,
+		Docker:            docker,
+		Nix:               nix,
+		GoModule:          goModule,
 		HomebrewTap:       homebrewTap,
 		SkipTests:         skipTests,
 		ProjectConfig:     projectConfig,
@@ -285,10 +344,19 @@ func (m *ReleaseModel) Update(msg tea.Msg) (*ReleaseModel, tea.Cmd) {
 		}
 		return m, nil
 
+	case models.ReleaseVerifiedMsg:
+		if m.Verifying && msg.Version == m.Version {
+			m.Verifying = false
+			m.Verification = msg.Checks
+		}
+		return m, nil
+
 	case models.ReleaseCompleteMsg:
 		if msg.Success {
 			m.Phase = models.PhaseComplete
 			m.CompletedDuration = msg.Duration  // Capture the final duration
+			m.Links = msg.Links
+			cmds = append(cmds, m.verifyRelease())
 
 			// Mark all steps as complete
 			for i := range m.Packages {
@@ -367,14 +435,21 @@ func (m *ReleaseModel) handleKeyPress(msg tea.KeyMsg) (*ReleaseModel, tea.Cmd) {
 			if m.SelectedVersion > 0 {
 				m.SelectedVersion--
 			}
+			m.RefreshModulePathCheck()
 			// Manage input focus based on selection
 			return m, m.updateInputFocus()
 		case "down", "j":
 			if m.SelectedVersion < 4 {
 				m.SelectedVersion++
 			}
+			m.RefreshModulePathCheck()
 			// Manage input focus based on selection
 			return m, m.updateInputFocus()
+		case "m":
+			if m.ModulePathCheck != nil {
+				m.UpdateModulePath = !m.UpdateModulePath
+				return m, nil
+			}
 		case "enter":
 			return m.startRelease()
 		}
@@ -383,6 +458,7 @@ func (m *ReleaseModel) handleKeyPress(msg tea.KeyMsg) (*ReleaseModel, tea.Cmd) {
 		if m.SelectedVersion == 4 {
 			var cmd tea.Cmd
 			m.VersionInput, cmd = m.VersionInput.Update(msg)
+			m.RefreshModulePathCheck()
 			return m, cmd
 		}
 
@@ -399,9 +475,14 @@ func (m *ReleaseModel) handleKeyPress(msg tea.KeyMsg) (*ReleaseModel, tea.Cmd) {
 		}
 	}
 
-	// Handle completion - ESC to dismiss
+	// Handle completion - ESC to dismiss, v to verify the channels again
 	if m.Phase == models.PhaseComplete {
 		switch msg.String() {
+		case "v":
+			if !m.Verifying {
+				return m, tea.Batch(m.Spinner.Tick, m.verifyRelease())
+			}
+			return m, nil
 		case "esc", "enter", " ":
 			// Reset to initial state - user will return to project view
 			m.Phase = models.PhaseVersionSelect
@@ -410,6 +491,8 @@ func (m *ReleaseModel) handleKeyPress(msg tea.KeyMsg) (*ReleaseModel, tea.Cmd) {
 			m.Installing = -1
 			m.Installed = []int{}
 			m.SelectedVersion = 0
+			m.Verifying = false
+			m.Verification = nil
 			for i := range m.Packages {
 				m.Packages[i].Status = "pending"
 			}
@@ -498,6 +581,12 @@ func (m *ReleaseModel) startRelease() (*ReleaseModel, tea.Cmd) {
 		SkipTests:      m.SkipTests,  // Use config value instead of hardcoded false
 		EnableHomebrew: m.EnableHomebrew,
 		EnableNPM:      m.EnableNPM,
+		EnableScoop:    m.EnableScoop,
+		Winget:         m.Winget,
+		AUR:            m.AUR,
+		Docker:         m.Docker,
+		Nix:            m.Nix,
+		GoModule:       m.GoModule,
 		HomebrewTap:    m.HomebrewTap,
 		RepoOwner:      m.RepoOwner,
 		RepoName:       m.RepoName,
@@ -505,6 +594,29 @@ func (m *ReleaseModel) startRelease() (*ReleaseModel, tea.Cmd) {
 		Changelog:      m.ChangelogInput.Value(),
 	}
 
+	if m.ProjectConfig != nil {
+		releaseConfig.Project = m.ProjectConfig.Project
+	}
+
+	if m.EnableNPM && m.ProjectConfig != nil && m.ProjectConfig.Config != nil {
+		releaseConfig.NPM = m.ProjectConfig.Config.Distributions.NPM
+	}
+
+	if m.GoModule != nil && m.ProjectConfig != nil && m.ProjectConfig.Project != nil && m.ProjectConfig.Project.Module != nil {
+		releaseConfig.Module = m.ProjectConfig.Project.Module.Name
+	}
+
+	if m.EnableHomebrew && m.ProjectConfig != nil && m.ProjectConfig.Config != nil {
+		releaseConfig.FormulaMigration = formulaMigration(m.ProjectConfig.Config.Distributions.Homebrew, m.ProjectConfig.Project)
+	}
+
+	if m.UpdateModulePath && m.ModulePathCheck != nil && m.ModulePathCheck.Version == version {
+		releaseConfig.ModulePath = m.ModulePathCheck.ExpectedPath
+	}
+
+	m.released = &releaseConfig
+	m.Verification = nil
+
 	// Start with the progress at 0
 	progressCmd := m.Progress.SetPercent(0)
 
@@ -525,6 +637,15 @@ func (m *ReleaseModel) runReleaseWithOutput(config executor.ReleaseConfig) tea.C
 	}
 }
 
+// verifyRelease checks the channels of the last release in the background
+func (m *ReleaseModel) verifyRelease() tea.Cmd {
+	if m.released == nil {
+		return nil
+	}
+	m.Verifying = true
+	return executor.NewReleaseExecutor(m.ProjectPath, *m.released).Verify()
+}
+
 func (m *ReleaseModel) detectPhaseFromOutput(line string) int {
 	line = strings.ToLower(line)
 
@@ -547,6 +668,30 @@ func (m *ReleaseModel) detectPhaseFromOutput(line string) int {
 	return -1
 }
 
+// RefreshModulePathCheck warns when the selected version is a major bump to
+// v2+ and go.mod does not declare the matching /vN module path.
+func (m *ReleaseModel) RefreshModulePathCheck() {
+	version := m.getSelectedVersion()
+	if version == "" || m.ProjectPath == "" || !gomod.IsMajorBump(m.CurrentVersion, version) {
+		m.ModulePathCheck = nil
+		m.UpdateModulePath = false
+		return
+	}
+
+	check, err := gomod.CheckModulePath(m.ProjectPath, version)
+	if err != nil || !check.NeedsUpdate {
+		m.ModulePathCheck = nil
+		m.UpdateModulePath = false
+		return
+	}
+
+	// Keep the user's choice while they stay on the same version
+	if m.ModulePathCheck == nil || m.ModulePathCheck.Version != version {
+		m.UpdateModulePath = false
+	}
+	m.ModulePathCheck = check
+}
+
 func (m *ReleaseModel) getSelectedVersion() string {
 	baseVersion := m.CurrentVersion
 	if baseVersion == "" {
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -8,6 +10,7 @@ import (
 
 	"distui/internal/config"
 	"distui/internal/detection"
+	"distui/internal/forge"
 	"distui/internal/models"
 )
 
@@ -18,11 +21,13 @@ type SettingsModel struct {
 	Editing         bool
 	Saved           bool
 	SelectedAccount int // For managing accounts list
+	TapManager      *TapManager
+	Error           string
 }
 
 func NewSettingsModel(globalConfig *models.GlobalConfig) *SettingsModel {
 	m := &SettingsModel{
-		Inputs: make([]textinput.Model, 5), // Added one more for accounts
+		Inputs: make([]textinput.Model, 6), // Added one more for accounts
 		Config: globalConfig,
 	}
 
@@ -65,13 +70,24 @@ func NewSettingsModel(globalConfig *models.GlobalConfig) *SettingsModel {
 			if globalConfig != nil && len(globalConfig.User.GitHubAccounts) > 0 {
 				for _, acc := range globalConfig.User.GitHubAccounts {
 					// Skip if it's the same as primary (avoid duplicates)
-					if acc.Username == primaryUsername && !acc.IsOrg {
+					if acc.Username == primaryUsername && !acc.IsOrg && acc.Host == "" {
 						continue
 					}
-					if acc.IsOrg {
-						accounts = append(accounts, "@"+acc.Username)
-					} else {
-						accounts = append(accounts, acc.Username)
+					accounts = append(accounts, formatGitHubAccount(acc))
+				}
+			}
+
+			// Offer GitHub Enterprise Server logins gh knows about
+			if userEnv != nil {
+				var hosts []string
+				for host := range userEnv.GitHubLogins {
+					hosts = append(hosts, host)
+				}
+				sort.Strings(hosts)
+				for _, host := range hosts {
+					entry := formatGitHubAccount(models.GitHubAccount{Username: userEnv.GitHubLogins[host], Host: host})
+					if host != "github.com" && !containsString(accounts, entry) {
+						accounts = append(accounts, entry)
 					}
 				}
 			}
@@ -102,6 +118,12 @@ func NewSettingsModel(globalConfig *models.GlobalConfig) *SettingsModel {
 			} else {
 				t.SetValue("patch")
 			}
+		case 5:
+			t.Placeholder = "forgejo:user@git.example.com"
+			if globalConfig != nil {
+				t.SetValue(forge.FormatAccounts(globalConfig.User.ForgeAccounts))
+			}
+			t.CharLimit = 256
 		}
 
 		m.Inputs[i] = t
@@ -127,6 +149,17 @@ func UpdateSettingsView(currentPage, previousPage int, msg tea.Msg, model *Setti
 		model = NewSettingsModel(nil)
 	}
 
+	if model.TapManager != nil {
+		switch msg.(type) {
+		case tapsLoadedMsg, tapBootstrappedMsg:
+			return currentPage, false, model.updateTapManager(msg), model
+		case tea.KeyMsg:
+			if model.TapManager.Active {
+				return currentPage, false, model.updateTapManager(msg), model
+			}
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -143,11 +176,19 @@ func UpdateSettingsView(currentPage, previousPage int, msg tea.Msg, model *Setti
 				model.Editing = true
 				return currentPage, false, nil, model
 			}
+		case "t":
+			if !model.Editing {
+				return currentPage, false, model.openTapManager(), model
+			}
 		case "enter":
 			if model.Editing {
 				if model.FocusIndex == len(model.Inputs) {
 					// Save button pressed
-					model.saveConfig()
+					if err := model.saveConfig(); err != nil {
+						model.Error = err.Error()
+						return currentPage, false, nil, model
+					}
+					model.Error = ""
 					model.Editing = false
 					model.Saved = true
 					return currentPage, false, nil, model
@@ -224,7 +265,12 @@ func (m *SettingsModel) updateInputs(msg tea.Msg) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-func (m *SettingsModel) saveConfig() {
+func (m *SettingsModel) saveConfig() error {
+	forgeAccounts, err := forge.ParseAccounts(m.Inputs[5].Value())
+	if err != nil {
+		return fmt.Errorf("forge accounts: %w", err)
+	}
+
 	if m.Config == nil {
 		m.Config = &models.GlobalConfig{
 			Version: "1.0",
@@ -253,26 +299,15 @@ func (m *SettingsModel) saveConfig() {
 		accounts := strings.Split(accountsStr, ",")
 		primaryFound := false
 
-		for _, acc := range accounts {
-			acc = strings.TrimSpace(acc)
-			isOrg := false
-			// Check for @ prefix to indicate organization
-			if strings.HasPrefix(acc, "@") {
-				isOrg = true
-				acc = strings.TrimPrefix(acc, "@")
-			}
-			if acc != "" {
-				isDefault := false
+		for _, entry := range accounts {
+			acc := parseGitHubAccount(entry)
+			if acc.Username != "" {
 				// First occurrence of primary username (not as org) is the default
-				if acc == primaryUsername && !isOrg && !primaryFound {
-					isDefault = true
+				if acc.Username == primaryUsername && !acc.IsOrg && acc.Host == "" && !primaryFound {
+					acc.Default = true
 					primaryFound = true
 				}
-				m.Config.User.GitHubAccounts = append(m.Config.User.GitHubAccounts, models.GitHubAccount{
-					Username: acc,
-					IsOrg:    isOrg,
-					Default:  isDefault,
-				})
+				m.Config.User.GitHubAccounts = append(m.Config.User.GitHubAccounts, acc)
 			}
 		}
 
@@ -300,6 +335,45 @@ func (m *SettingsModel) saveConfig() {
 	m.Config.User.DefaultHomebrewTap = m.Inputs[2].Value()
 	m.Config.User.NPMScope = m.Inputs[3].Value()
 	m.Config.Preferences.DefaultVersionBump = m.Inputs[4].Value()
+	m.Config.User.ForgeAccounts = forgeAccounts
+	forge.SetAccounts(forgeAccounts)
+	forge.SetGitHubAccounts(m.Config.User.GitHubAccounts)
 
-	config.SaveGlobalConfig(m.Config)
-}
\ No newline at end of file
+	return config.SaveGlobalConfig(m.Config)
+}
+// parseGitHubAccount reads user, @org, user@host or @org@host, the host being a GitHub
+// Enterprise Server
+func parseGitHubAccount(entry string) models.GitHubAccount {
+	entry = strings.TrimSpace(entry)
+	acc := models.GitHubAccount{}
+	if strings.HasPrefix(entry, "@") {
+		acc.IsOrg = true
+		entry = strings.TrimPrefix(entry, "@")
+	}
+	acc.Username, acc.Host, _ = strings.Cut(entry, "@")
+	acc.Host = strings.ToLower(strings.TrimSpace(acc.Host))
+	if acc.Host == forge.DefaultGitHubHost {
+		acc.Host = ""
+	}
+	return acc
+}
+
+func formatGitHubAccount(acc models.GitHubAccount) string {
+	entry := acc.Username
+	if acc.IsOrg {
+		entry = "@" + entry
+	}
+	if acc.Host != "" {
+		entry += "@" + acc.Host
+	}
+	return entry
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
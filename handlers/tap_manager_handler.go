@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/taps"
+)
+
+// TapManager lists the Homebrew taps of the configured GitHub accounts
+type TapManager struct {
+	Active           bool
+	Loading          bool
+	Taps             []taps.Tap
+	Err              string
+	Offset           int
+	Owner            string // Account a new tap would be created for
+	ConfirmBootstrap bool
+	Bootstrapping    bool
+	Status           string
+}
+
+type tapsLoadedMsg struct {
+	taps []taps.Tap
+	err  error
+}
+
+type tapBootstrappedMsg struct {
+	path string
+	err  error
+}
+
+func loadTapsCmd(owners []string) tea.Cmd {
+	return func() tea.Msg {
+		found, err := taps.Discover(owners)
+		return tapsLoadedMsg{taps: found, err: err}
+	}
+}
+
+func bootstrapTapCmd(owner string) tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return tapBootstrappedMsg{err: err}
+		}
+		path, err := taps.Bootstrap(owner, home)
+		return tapBootstrappedMsg{path: path, err: err}
+	}
+}
+
+// CanBootstrap reports whether Owner has no homebrew-tap repository yet
+func (t *TapManager) CanBootstrap() bool {
+	if t.Loading || t.Err != "" || t.Owner == "" {
+		return false
+	}
+	for _, tap := range t.Taps {
+		if tap.Repo == t.Owner+"/"+taps.DefaultTapName {
+			return false
+		}
+	}
+	return true
+}
+
+// Rows is the number of list lines, a header per tap plus a line per package
+func (t *TapManager) Rows() int {
+	rows := 0
+	for _, tap := range t.Taps {
+		rows += 1 + max(len(tap.Packages), 1)
+	}
+	return rows
+}
+
+func (m *SettingsModel) tapOwners() []string {
+	var owners []string
+	seen := map[string]bool{}
+	add := func(owner string) {
+		if owner != "" && !seen[owner] {
+			seen[owner] = true
+			owners = append(owners, owner)
+		}
+	}
+	if m.Config != nil {
+		add(m.Config.User.GitHubUsername)
+		for _, acc := range m.Config.User.GitHubAccounts {
+			add(acc.Username)
+		}
+	}
+	add(m.Inputs[0].Value())
+	return owners
+}
+
+func (m *SettingsModel) openTapManager() tea.Cmd {
+	owners := m.tapOwners()
+	m.TapManager = &TapManager{Active: true, Loading: true}
+	if len(owners) == 0 {
+		m.TapManager.Loading = false
+		m.TapManager.Err = "no GitHub account configured, press [e] to add one"
+		return nil
+	}
+	m.TapManager.Owner = owners[0]
+	return loadTapsCmd(owners)
+}
+
+func (m *SettingsModel) updateTapManager(msg tea.Msg) tea.Cmd {
+	t := m.TapManager
+	switch msg := msg.(type) {
+	case tapsLoadedMsg:
+		t.Loading = false
+		t.Taps = msg.taps
+		t.Err = ""
+		if msg.err != nil {
+			t.Err = msg.err.Error()
+		}
+		return nil
+
+	case tapBootstrappedMsg:
+		t.Bootstrapping = false
+		if msg.err != nil {
+			t.Status = "Creating tap failed: " + msg.err.Error()
+			return nil
+		}
+		t.Status = "Created " + t.Owner + "/" + taps.DefaultTapName + " at " + msg.path
+		if m.Config != nil && m.Config.User.DefaultHomebrewTap == "" {
+			m.Config.User.DefaultHomebrewTap = t.Owner + "/" + taps.DefaultTapName
+			m.Inputs[2].SetValue(m.Config.User.DefaultHomebrewTap)
+			m.saveConfig()
+			m.Saved = true
+		}
+		t.Loading = true
+		return loadTapsCmd(m.tapOwners())
+
+	case tea.KeyMsg:
+		if t.ConfirmBootstrap {
+			t.ConfirmBootstrap = false
+			if msg.String() == "y" {
+				t.Bootstrapping = true
+				t.Status = ""
+				return bootstrapTapCmd(t.Owner)
+			}
+			return nil
+		}
+		switch msg.String() {
+		case "esc":
+			t.Active = false
+		case "r":
+			if !t.Loading && !t.Bootstrapping {
+				t.Loading = true
+				t.Status = ""
+				return loadTapsCmd(m.tapOwners())
+			}
+		case "b":
+			if t.CanBootstrap() && !t.Bootstrapping {
+				t.ConfirmBootstrap = true
+			}
+		case "up", "k":
+			if t.Offset > 0 {
+				t.Offset--
+			}
+		case "down", "j":
+			if t.Offset < t.Rows()-1 {
+				t.Offset++
+			}
+		}
+	}
+	return nil
+}
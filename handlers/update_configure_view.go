@@ -9,6 +9,7 @@ import (
 
 	"distui/internal/config"
 	"distui/internal/gitcleanup"
+	"distui/internal/goreleaser"
 )
 
 // UpdateConfigureView handles configure view updates and navigation
@@ -177,18 +178,137 @@ func UpdateConfigureView(currentPage, previousPage int, msg tea.Msg, configModel
 			}
 		} else if configModel.CurrentView == GenerateConfigConsent {
 			switch msg.String() {
-			case "y", "Y":
-				// Start async file generation with spinner
+			case "y", "Y", "enter":
+				if configModel.ConfigDiff == nil || configModel.ConfigDiff.AcceptedCount() == 0 {
+					configModel.CurrentView = TabView
+					configModel.ConfigDiff = nil
+					return currentPage, false, nil, configModel
+				}
+				// Write exactly what was previewed
 				configModel.GeneratingFiles = true
-				configModel.GenerateStatus = "Generating release files..."
+				configModel.GenerateStatus = "Writing release files..."
 				return currentPage, false, tea.Batch(
 					configModel.CreateSpinner.Tick,
-					generateFilesCmd(configModel.DetectedProject, configModel.ProjectConfig, configModel.PendingGenerateFiles, configModel.PendingDeleteFiles),
+					applyConfigDiffsCmd(configModel.DetectedProject.Path, configModel.ConfigDiff.Files),
 				), configModel
 			case "n", "N", "esc":
 				configModel.CurrentView = TabView
 				configModel.PendingGenerateFiles = nil
 				configModel.PendingDeleteFiles = nil
+				configModel.ConfigDiff = nil
+				return currentPage, false, nil, configModel
+			default:
+				if configModel.ConfigDiff != nil {
+					configModel.ConfigDiff = configModel.ConfigDiff.Update(msg)
+				}
+				return currentPage, false, nil, configModel
+			}
+		} else if configModel.CurrentView == ArchiveSettingsView {
+			switch msg.String() {
+			case "esc":
+				configModel.CurrentView = TabView
+				configModel.ArchiveModel = nil
+				return currentPage, false, nil, configModel
+			case "enter":
+				if err := configModel.saveArchiveSettings(); err != nil {
+					configModel.ArchiveModel.Error = err.Error()
+					return currentPage, false, nil, configModel
+				}
+				configModel.CurrentView = TabView
+				configModel.ArchiveModel = nil
+				return currentPage, false, nil, configModel
+			default:
+				if configModel.ArchiveModel != nil {
+					var cmd tea.Cmd
+					configModel.ArchiveModel, cmd = configModel.ArchiveModel.Update(msg)
+					return currentPage, false, cmd, configModel
+				}
+			}
+		} else if configModel.CurrentView == ChannelSettingsView {
+			if configModel.ChannelModel != nil && configModel.ChannelModel.Confirm != "" {
+				configModel.ChannelModel.Confirm = ""
+				if msg.String() != "y" {
+					return currentPage, false, nil, configModel
+				}
+				configModel.ChannelModel.Confirmed = true
+				msg = tea.KeyMsg{Type: tea.KeyEnter}
+			}
+			switch msg.String() {
+			case "esc":
+				configModel.CurrentView = TabView
+				configModel.ChannelModel = nil
+				return currentPage, false, nil, configModel
+			case "enter":
+				key := configModel.ChannelModel.Key
+				if err := configModel.saveChannelSettings(); err == errNeedsConfirm {
+					return currentPage, false, nil, configModel
+				} else if err != nil {
+					configModel.ChannelModel.Error = err.Error()
+					return currentPage, false, nil, configModel
+				}
+				configModel.CurrentView = TabView
+				configModel.ChannelModel = nil
+				if key == "npm" {
+					return currentPage, false, configModel.startNPMNameCheck(), configModel
+				}
+				return currentPage, false, nil, configModel
+			default:
+				if configModel.ChannelModel != nil {
+					var cmd tea.Cmd
+					configModel.ChannelModel, cmd = configModel.ChannelModel.Update(msg)
+					return currentPage, false, cmd, configModel
+				}
+			}
+		} else if configModel.CurrentView == PackageCheckView {
+			switch msg.String() {
+			case "esc", "q":
+				configModel.CurrentView = TabView
+				configModel.PackageReports = nil
+				configModel.PackageCheckError = ""
+				return currentPage, false, nil, configModel
+			case "t":
+				configModel.CurrentView = TabView
+				return currentPage, false, configModel.startPackageCheck(), configModel
+			default:
+				return currentPage, false, nil, configModel
+			}
+		} else if configModel.CurrentView == BrewPreviewView {
+			switch msg.String() {
+			case "esc", "q":
+				configModel.CurrentView = TabView
+				configModel.BrewPreview = nil
+				configModel.BrewPreviewError = ""
+				return currentPage, false, nil, configModel
+			case "t":
+				configModel.CurrentView = TabView
+				return currentPage, false, configModel.startBrewPreview(), configModel
+			case "down", "j":
+				if configModel.BrewPreview != nil {
+					configModel.BrewPreview.Scroll(1)
+				}
+			case "up", "k":
+				if configModel.BrewPreview != nil {
+					configModel.BrewPreview.Scroll(-1)
+				}
+			case "pgdown":
+				if configModel.BrewPreview != nil {
+					configModel.BrewPreview.Scroll(10)
+				}
+			case "pgup":
+				if configModel.BrewPreview != nil {
+					configModel.BrewPreview.Scroll(-10)
+				}
+			}
+			return currentPage, false, nil, configModel
+		} else if configModel.CurrentView == ConfigIssuesView {
+			switch msg.String() {
+			case "esc", "v", "q":
+				configModel.CurrentView = TabView
+				return currentPage, false, nil, configModel
+			case "u":
+				// Falls through to the upgrade handler below
+				configModel.CurrentView = TabView
+			default:
 				return currentPage, false, nil, configModel
 			}
 		} else if configModel.CurrentView == GitHubView {
@@ -285,10 +405,58 @@ func UpdateConfigureView(currentPage, previousPage int, msg tea.Msg, configModel
 				configModel.PendingGenerateFiles = changes.FilesToGenerate
 				configModel.PendingDeleteFiles = changes.FilesToDelete
 			}
+
+			diffs, err := BuildConfigDiffs(configModel.DetectedProject, configModel.ProjectConfig, configModel.PendingGenerateFiles, configModel.PendingDeleteFiles)
+			if err != nil {
+				configModel.CreateStatus = fmt.Sprintf("✗ %v", err)
+				return currentPage, false, nil, configModel
+			}
+			configModel.ConfigDiff = NewConfigDiffModel(diffs, configModel.Width, configModel.Height)
+
 			configModel.CurrentView = GenerateConfigConsent
 			return currentPage, false, nil, configModel
 		}
 
+		// Handle 'v' key to show goreleaser config validation issues
+		if msg.String() == "v" && configModel.CurrentView == TabView && configModel.ActiveTab != 0 {
+			configModel.refreshConfigIssues()
+			if configModel.ConfigIssuesFile != "" {
+				configModel.CurrentView = ConfigIssuesView
+			}
+			return currentPage, false, nil, configModel
+		}
+
+		// Handle 'u' key to upgrade deprecated goreleaser keys, previewed as a diff
+		if msg.String() == "u" && configModel.CurrentView == TabView && configModel.ActiveTab != 0 {
+			if configModel.DetectedProject != nil && goreleaser.HasFixable(configModel.ConfigIssues) {
+				diff, err := PrepareGoReleaserMigration(configModel.DetectedProject.Path)
+				if err != nil {
+					configModel.CreateStatus = fmt.Sprintf("✗ %v", err)
+					return currentPage, false, nil, configModel
+				}
+				configModel.ConfigDiff = NewConfigDiffModel([]FileDiff{*diff}, configModel.Width, configModel.Height)
+				configModel.ConfigDiff.Title = "UPGRADE DEPRECATED GORELEASER KEYS"
+				configModel.ConfigDiff.Migration = true
+				configModel.CurrentView = GenerateConfigConsent
+			}
+			return currentPage, false, nil, configModel
+		}
+
+		// Handle 'M' key to let distui manage its sections inside a custom .goreleaser.yaml
+		if msg.String() == "M" && configModel.CurrentView == TabView && configModel.ActiveTab != 0 {
+			if configModel.ProjectConfig != nil && configModel.ProjectConfig.CustomFilesMode && !configModel.ProjectConfig.MergeCustomGoReleaser {
+				if err := EnableGoReleaserMerge(configModel.DetectedProject, configModel.ProjectConfig); err != nil {
+					configModel.CreateStatus = fmt.Sprintf("✗ %v", err)
+					return currentPage, false, nil, configModel
+				}
+				config.SaveProject(configModel.ProjectConfig)
+
+				configModel.refreshDistributionsList()
+				configModel.NeedsRegeneration = true
+			}
+			return currentPage, false, nil, configModel
+		}
+
 		// Handle 'P' key to open branch selection modal (only in TabView, Cleanup tab)
 		if msg.String() == "P" && configModel.CurrentView == TabView && configModel.ActiveTab == 0 {
 			if configModel.CleanupModel != nil && configModel.CleanupModel.RepoInfo != nil &&
@@ -309,7 +477,7 @@ func UpdateConfigureView(currentPage, previousPage int, msg tea.Msg, configModel
 		if msg.String() == "G" && configModel != nil && !configModel.CreatingRepo && configModel.CurrentView == TabView && configModel.ActiveTab == 0 {
 			// Check if we need to create a GitHub repo
 			if gitcleanup.HasGitRepo() {
-				if !gitcleanup.HasGitHubRemote() || !gitcleanup.CheckGitHubRepoExists() {
+				if !gitcleanup.HasForgeRemote() || !gitcleanup.CheckGitHubRepoExists() {
 					// Enter repo creation mode
 					configModel.CreatingRepo = true
 					configModel.RepoInputFocus = 0
@@ -391,7 +559,11 @@ func UpdateConfigureView(currentPage, previousPage int, msg tea.Msg, configModel
 				// Get the owner (account) to create under
 				owner := ""
 				if len(configModel.GitHubAccounts) > 0 && configModel.SelectedAccountIdx < len(configModel.GitHubAccounts) {
-					owner = configModel.GitHubAccounts[configModel.SelectedAccountIdx].Username
+					acc := configModel.GitHubAccounts[configModel.SelectedAccountIdx]
+					owner = acc.Username
+					if acc.Host != "" {
+						owner = acc.Host + "/" + acc.Username
+					}
 				}
 
 				// Return commands for both spinner and repo creation
@@ -431,11 +603,6 @@ func UpdateConfigureView(currentPage, previousPage int, msg tea.Msg, configModel
 		case "q", "ctrl+c":
 			return currentPage, true, tea.Quit, configModel
 		case "esc":
-			// If in NPM edit mode, delegate to model's Update to handle cancellation
-			if configModel != nil && configModel.NPMEditMode {
-				newModel, cmd := configModel.Update(msg)
-				return currentPage, false, cmd, newModel
-			}
 			// If we're in a nested view, return to TabView (shouldn't normally reach here)
 			if configModel != nil && configModel.CurrentView != TabView {
 				configModel.CurrentView = TabView
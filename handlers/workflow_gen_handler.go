@@ -28,7 +28,7 @@ func NewWorkflowGenModel(projectConfig *models.ProjectConfig, projectPath string
 		projectConfig.Config.CICD = &models.CICDSettings{
 			GitHubActions: &models.GitHubActionsConfig{
 				Enabled:        false,
-				WorkflowPath:   ".github/workflows/release.yml",
+				WorkflowPath:   workflow.DefaultWorkflowPath,
 				IncludeTests:   true,
 				AutoRegenerate: false,
 			},
@@ -38,7 +38,7 @@ func NewWorkflowGenModel(projectConfig *models.ProjectConfig, projectPath string
 	if projectConfig.Config.CICD.GitHubActions == nil {
 		projectConfig.Config.CICD.GitHubActions = &models.GitHubActionsConfig{
 			Enabled:        false,
-			WorkflowPath:   ".github/workflows/release.yml",
+			WorkflowPath:   workflow.DefaultWorkflowPath,
 			IncludeTests:   true,
 			AutoRegenerate: false,
 		}
@@ -157,7 +157,7 @@ func (m *WorkflowGenModel) promptGenerate() {
 
 	m.GeneratedYAML = yamlContent
 
-	exists := workflow.WorkflowExists(m.ProjectPath)
+	exists := workflow.WorkflowExists(m.ProjectPath, m.ProjectConfig)
 	m.ConfirmOverwrite = exists
 	m.ShowConfirm = true
 	m.Error = ""
@@ -169,7 +169,7 @@ func (m *WorkflowGenModel) generateWorkflow() {
 		return
 	}
 
-	err := workflow.WriteWorkflowFile(m.ProjectPath, m.GeneratedYAML)
+	err := workflow.WriteWorkflowFile(m.ProjectPath, m.ProjectConfig, m.GeneratedYAML)
 	if err != nil {
 		m.Error = err.Error()
 		return
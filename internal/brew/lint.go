@@ -0,0 +1,192 @@
+package brew
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	classRe     = regexp.MustCompile(`^class (\S+) < Formula$`)
+	caskRe      = regexp.MustCompile(`^cask "([^"]*)" do$`)
+	caskTokenRe = regexp.MustCompile(`^[a-z0-9][a-z0-9.+@-]*$`)
+	descRe      = regexp.MustCompile(`^\s*desc "(.*)"$`)
+	urlRe       = regexp.MustCompile(`^\s*url "[^"]+"`)
+	sha256Re    = regexp.MustCompile(`^\s*sha256 "([^"]*)"$`)
+	hexDigestRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	openerRe    = regexp.MustCompile(`^\s*(class|def|if|unless|case|begin|module)\b|\bdo(\s*\|[^|]*\|)?$`)
+	heredocRe   = regexp.MustCompile(`<<[~-]?([A-Z_]+)$`)
+	homepageRe  = regexp.MustCompile(`^\s*homepage "[^"]+"$`)
+	binaryRe    = regexp.MustCompile(`^\s*binary "`)
+	installRe   = regexp.MustCompile(`^\s*def install$`)
+)
+
+// Lint checks the structure of a rendered formula or cask and returns the problems found.
+// It covers the mistakes brew audit reports most, without needing brew.
+func Lint(spec *Spec, content string) []string {
+	var problems []string
+	lines := strings.Split(content, "\n")
+
+	if spec.Cask {
+		problems = append(problems, lintCaskHeader(spec, lines)...)
+	} else {
+		problems = append(problems, lintFormulaHeader(spec, lines)...)
+	}
+	problems = append(problems, lintMetadata(lines)...)
+	problems = append(problems, lintDownloads(lines)...)
+
+	if spec.Cask {
+		if !hasLine(lines, binaryRe) {
+			problems = append(problems, "no binary stanza, the cask wouldn't link anything")
+		}
+	} else {
+		problems = append(problems, lintTestBlock(lines)...)
+		if !hasLine(lines, installRe) {
+			problems = append(problems, "no install method")
+		}
+	}
+
+	if opens, ends := countBlocks(lines); opens != ends {
+		problems = append(problems, fmt.Sprintf("unbalanced blocks: %d openers but %d end lines", opens, ends))
+	}
+	return problems
+}
+
+func lintFormulaHeader(spec *Spec, lines []string) []string {
+	for _, line := range lines {
+		if match := classRe.FindStringSubmatch(line); match != nil {
+			if want := ClassName(spec.Name); match[1] != want {
+				return []string{fmt.Sprintf("class %s should be %s for formula %s", match[1], want, spec.Name)}
+			}
+			return nil
+		}
+	}
+	return []string{"no \"class ... < Formula\" line"}
+}
+
+func lintCaskHeader(spec *Spec, lines []string) []string {
+	for _, line := range lines {
+		if match := caskRe.FindStringSubmatch(line); match != nil {
+			if !caskTokenRe.MatchString(match[1]) {
+				return []string{fmt.Sprintf("cask token %q must be lowercase letters, digits and dashes", match[1])}
+			}
+			if match[1] != spec.Name {
+				return []string{fmt.Sprintf("cask token %q doesn't match the file name %s.rb", match[1], spec.Name)}
+			}
+			return nil
+		}
+	}
+	return []string{"no \"cask \"...\" do\" line"}
+}
+
+func lintMetadata(lines []string) []string {
+	var problems []string
+	desc := ""
+	found := false
+	for _, line := range lines {
+		if match := descRe.FindStringSubmatch(line); match != nil {
+			desc, found = match[1], true
+			break
+		}
+	}
+	switch {
+	case !found || desc == "":
+		problems = append(problems, "desc is missing")
+	case len(desc) > 80:
+		problems = append(problems, fmt.Sprintf("desc is %d characters, brew allows 80", len(desc)))
+	case strings.HasPrefix(desc, "A ") || strings.HasPrefix(desc, "An "):
+		problems = append(problems, "desc shouldn't start with an article")
+	case strings.HasSuffix(desc, "."):
+		problems = append(problems, "desc shouldn't end with a period")
+	}
+	if !hasLine(lines, homepageRe) {
+		problems = append(problems, "homepage is missing")
+	}
+	return problems
+}
+
+// lintDownloads wants every url followed by a sha256 before the next url
+func lintDownloads(lines []string) []string {
+	var problems []string
+	urls := 0
+	pending := false
+	for _, line := range lines {
+		if urlRe.MatchString(line) {
+			if pending {
+				problems = append(problems, "url without sha256: "+strings.TrimSpace(line))
+			}
+			urls++
+			pending = true
+			continue
+		}
+		if match := sha256Re.FindStringSubmatch(line); match != nil {
+			if !hexDigestRe.MatchString(match[1]) {
+				problems = append(problems, fmt.Sprintf("sha256 %q is not a 64 character hex digest", match[1]))
+			}
+			if !pending {
+				problems = append(problems, "sha256 without a url")
+			}
+			pending = false
+		}
+	}
+	if urls == 0 {
+		problems = append(problems, "no url, nothing to download")
+	}
+	if pending {
+		problems = append(problems, "last url has no sha256")
+	}
+	return problems
+}
+
+func lintTestBlock(lines []string) []string {
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "test do" {
+			continue
+		}
+		for _, next := range lines[i+1:] {
+			if strings.TrimSpace(next) == "end" {
+				return []string{"test block is empty"}
+			}
+			if strings.TrimSpace(next) != "" {
+				return nil
+			}
+		}
+	}
+	return []string{"no test block, brew audit requires one"}
+}
+
+// countBlocks counts block openers and end lines, skipping heredoc bodies
+func countBlocks(lines []string) (int, int) {
+	opens, ends := 0, 0
+	heredoc := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if heredoc != "" {
+			if trimmed == heredoc {
+				heredoc = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if match := heredocRe.FindStringSubmatch(trimmed); match != nil {
+			heredoc = match[1]
+		}
+		if trimmed == "end" {
+			ends++
+		} else if openerRe.MatchString(trimmed) {
+			opens++
+		}
+	}
+	return opens, ends
+}
+
+func hasLine(lines []string, re *regexp.Regexp) bool {
+	for _, line := range lines {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
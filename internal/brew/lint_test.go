@@ -0,0 +1,62 @@
+package brew
+
+import (
+	"strings"
+	"testing"
+)
+
+const validFormula = `class MyTool < Formula
+  desc "Ships tools"
+  homepage "https://github.com/acme/my-tool"
+  version "1.2.3"
+
+  on_macos do
+    if Hardware::CPU.arm?
+      url "https://example.com/my-tool.tar.gz"
+      sha256 "` + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef" + `"
+
+      def install
+        bin.install "my-tool"
+      end
+    end
+  end
+
+  test do
+    system "#{bin}/my-tool", "--version"
+  end
+end
+`
+
+func TestLintFormula(t *testing.T) {
+	spec := &Spec{Name: "my-tool"}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"valid", validFormula, ""},
+		{"class casing", strings.Replace(validFormula, "class MyTool", "class Mytool", 1), "class Mytool should be MyTool"},
+		{"missing sha256", strings.Replace(validFormula, `      sha256 "0123`, `      # sha256 "0123`, 1), "last url has no sha256"},
+		{"short sha256", strings.Replace(validFormula, "0123456789abcdef\"", "\"", 1), "not a 64 character hex digest"},
+		{"no test", strings.Replace(validFormula, "  test do\n    system \"#{bin}/my-tool\", \"--version\"\n  end\n", "", 1), "no test block"},
+		{"empty test", strings.Replace(validFormula, "    system \"#{bin}/my-tool\", \"--version\"\n", "", 1), "test block is empty"},
+		{"desc period", strings.Replace(validFormula, `desc "Ships tools"`, `desc "Ships tools."`, 1), "period"},
+		{"unbalanced", strings.Replace(validFormula, "      end\n    end\n", "      end\n", 1), "unbalanced blocks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := Lint(spec, tt.content)
+			if tt.want == "" {
+				if len(problems) > 0 {
+					t.Errorf("Expected no problems, got %v", problems)
+				}
+				return
+			}
+			if !strings.Contains(strings.Join(problems, "\n"), tt.want) {
+				t.Errorf("Expected a problem containing %q, got %v", tt.want, problems)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package brew
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// PreviewVersion is the release version used for rendering, matching the archive name preview
+const PreviewVersion = "1.2.3"
+
+// Artifact is one archive a formula or cask can download
+type Artifact struct {
+	OS     string
+	Arch   string
+	URL    string
+	SHA256 string
+}
+
+var classWordRe = regexp.MustCompile(`[-_.\s]([a-zA-Z0-9])`)
+
+var classVersionRe = regexp.MustCompile(`(.)@(\d)`)
+
+// ClassName is the Ruby class brew expects for a formula name, tool-cli@2 becomes ToolCliAT2
+func ClassName(name string) string {
+	if name == "" {
+		return ""
+	}
+	class := strings.ToUpper(name[:1]) + strings.ToLower(name[1:])
+	class = classWordRe.ReplaceAllStringFunc(class, func(match string) string {
+		return strings.ToUpper(match[1:])
+	})
+	class = strings.ReplaceAll(class, "+", "x")
+	return classVersionRe.ReplaceAllString(class, "${1}AT${2}")
+}
+
+// FakeArtifacts names the macOS and Linux archives of an example release the way
+// the archive settings would, with checksums that are well formed but made up
+func FakeArtifacts(project *models.ProjectInfo, settings *models.ArchiveSettings) ([]Artifact, error) {
+	if project == nil || project.Repository == nil {
+		return nil, fmt.Errorf("repository information required for download urls")
+	}
+
+	var artifacts []Artifact
+	for _, goos := range []string{"darwin", "linux"} {
+		for _, goarch := range []string{"amd64", "arm64"} {
+			name, err := generator.PreviewArchiveName(project, settings, goos, goarch)
+			if err != nil {
+				return nil, err
+			}
+			sum := sha256.Sum256([]byte(name))
+			artifacts = append(artifacts, Artifact{
+				OS:     goos,
+				Arch:   goarch,
+				URL:    fmt.Sprintf("%s/releases/download/v%s/%s", generator.ProjectURL(project), PreviewVersion, name),
+				SHA256: hex.EncodeToString(sum[:]),
+			})
+		}
+	}
+	return artifacts, nil
+}
+
+// Render produces the Ruby file GoReleaser would push for spec
+func Render(spec *Spec, artifacts []Artifact) string {
+	if spec.Cask {
+		return renderCask(spec, artifacts)
+	}
+	return renderFormula(spec, artifacts)
+}
+
+func renderFormula(spec *Spec, artifacts []Artifact) string {
+	var b strings.Builder
+	writeHeader(&b)
+	b.WriteString(fmt.Sprintf("class %s < Formula\n", ClassName(spec.Name)))
+	writeMetadata(&b, spec)
+	if spec.License != "" {
+		b.WriteString(fmt.Sprintf("  license %q\n", spec.License))
+	}
+	b.WriteString("\n")
+
+	for _, dep := range spec.Dependencies {
+		b.WriteString(fmt.Sprintf("  depends_on %q\n", dep))
+	}
+	if len(spec.Dependencies) > 0 {
+		b.WriteString("\n")
+	}
+
+	install := spec.Install
+	if strings.TrimSpace(install) == "" {
+		install = fmt.Sprintf("bin.install %q", spec.Name)
+	}
+
+	for _, goos := range []string{"darwin", "linux"} {
+		block := "on_macos"
+		conditions := map[string]string{"amd64": "Hardware::CPU.intel?", "arm64": "Hardware::CPU.arm?"}
+		if goos == "linux" {
+			block = "on_linux"
+			conditions = map[string]string{
+				"amd64": "Hardware::CPU.intel? && Hardware::CPU.is_64_bit?",
+				"arm64": "Hardware::CPU.arm? && Hardware::CPU.is_64_bit?",
+			}
+		}
+		b.WriteString("  " + block + " do\n")
+		for _, artifact := range artifactsFor(artifacts, goos) {
+			b.WriteString("    if " + conditions[artifact.Arch] + "\n")
+			b.WriteString(fmt.Sprintf("      url %q\n", artifact.URL))
+			b.WriteString(fmt.Sprintf("      sha256 %q\n\n", artifact.SHA256))
+			b.WriteString("      def install\n")
+			writeIndented(&b, install, "        ")
+			b.WriteString("      end\n")
+			b.WriteString("    end\n")
+		}
+		b.WriteString("  end\n\n")
+	}
+
+	if strings.TrimSpace(spec.PostInstall) != "" {
+		b.WriteString("  def post_install\n")
+		writeIndented(&b, spec.PostInstall, "    ")
+		b.WriteString("  end\n\n")
+	}
+	writeCaveats(&b, spec)
+
+	if strings.TrimSpace(spec.Test) != "" {
+		b.WriteString("  test do\n")
+		writeIndented(&b, spec.Test, "    ")
+		b.WriteString("  end\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\nend\n"
+}
+
+func renderCask(spec *Spec, artifacts []Artifact) string {
+	var b strings.Builder
+	writeHeader(&b)
+	b.WriteString(fmt.Sprintf("cask %q do\n", spec.Name))
+	b.WriteString(fmt.Sprintf("  name %q\n", spec.Name))
+	writeMetadata(&b, spec)
+	b.WriteString("\n")
+	b.WriteString("  livecheck do\n")
+	b.WriteString("    skip \"Auto-generated on release.\"\n")
+	b.WriteString("  end\n\n")
+
+	binaries := spec.Binaries
+	if len(binaries) == 0 {
+		binaries = []string{spec.Name}
+	}
+	for _, binary := range binaries {
+		b.WriteString(fmt.Sprintf("  binary %q\n", binary))
+	}
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		if path := spec.Completions[shell]; path != "" {
+			b.WriteString(fmt.Sprintf("  %s_completion %q\n", shell, path))
+		}
+	}
+	for _, manpage := range spec.Manpages {
+		b.WriteString(fmt.Sprintf("  manpage %q\n", manpage))
+	}
+	b.WriteString("\n")
+
+	for _, goos := range []string{"darwin", "linux"} {
+		block := "on_macos"
+		if goos == "linux" {
+			block = "on_linux"
+		}
+		b.WriteString("  " + block + " do\n")
+		for _, artifact := range artifactsFor(artifacts, goos) {
+			arch := "on_intel"
+			if artifact.Arch == "arm64" {
+				arch = "on_arm"
+			}
+			b.WriteString("    " + arch + " do\n")
+			b.WriteString(fmt.Sprintf("      url %q\n", artifact.URL))
+			b.WriteString(fmt.Sprintf("      sha256 %q\n", artifact.SHA256))
+			b.WriteString("    end\n")
+		}
+		b.WriteString("  end\n\n")
+	}
+
+	if strings.TrimSpace(spec.PostInstallHook) != "" {
+		b.WriteString("  postflight do\n")
+		writeIndented(&b, spec.PostInstallHook, "    ")
+		b.WriteString("  end\n\n")
+	}
+	writeCaveats(&b, spec)
+
+	return strings.TrimRight(b.String(), "\n") + "\nend\n"
+}
+
+func writeHeader(b *strings.Builder) {
+	b.WriteString("# typed: false\n")
+	b.WriteString("# frozen_string_literal: true\n\n")
+	b.WriteString("# This file was generated by GoReleaser. DO NOT EDIT.\n")
+}
+
+func writeMetadata(b *strings.Builder, spec *Spec) {
+	if spec.Description != "" {
+		b.WriteString(fmt.Sprintf("  desc %q\n", spec.Description))
+	}
+	if spec.Homepage != "" {
+		b.WriteString(fmt.Sprintf("  homepage %q\n", spec.Homepage))
+	}
+	b.WriteString(fmt.Sprintf("  version %q\n", PreviewVersion))
+}
+
+func writeCaveats(b *strings.Builder, spec *Spec) {
+	if strings.TrimSpace(spec.Caveats) == "" {
+		return
+	}
+	if spec.Cask {
+		b.WriteString("  caveats <<~EOS\n")
+		writeIndented(b, spec.Caveats, "    ")
+		b.WriteString("  EOS\n\n")
+		return
+	}
+	b.WriteString("  def caveats\n")
+	b.WriteString("    <<~EOS\n")
+	writeIndented(b, spec.Caveats, "      ")
+	b.WriteString("    EOS\n")
+	b.WriteString("  end\n\n")
+}
+
+func writeIndented(b *strings.Builder, text, indent string) {
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(indent + line + "\n")
+	}
+}
+
+func artifactsFor(artifacts []Artifact, goos string) []Artifact {
+	var matching []Artifact
+	for _, artifact := range artifacts {
+		if artifact.OS == goos {
+			matching = append(matching, artifact)
+		}
+	}
+	return matching
+}
@@ -0,0 +1,77 @@
+package brew
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+func TestClassName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"tool", "Tool"},
+		{"my-tool", "MyTool"},
+		{"my_tool.cli", "MyToolCli"},
+		{"TOOL", "Tool"},
+		{"tool@2", "ToolAT2"},
+		{"c++tool", "Cxxtool"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassName(tt.name); got != tt.want {
+				t.Errorf("ClassName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// The files rendered from distui's own configs should pass the lint
+func TestRenderGeneratedConfigs(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "my-tool"},
+		Binary:     &models.BinaryInfo{Name: "my-tool"},
+	}
+
+	for _, pkg := range []string{"cask", "formula"} {
+		t.Run(pkg, func(t *testing.T) {
+			config := &models.ProjectConfig{Config: &models.ProjectSettings{
+				Distributions: models.Distributions{Homebrew: &models.HomebrewConfig{Enabled: true,
+					TapRepo: "acme/homebrew-tap", Package: pkg, Description: "Ships tools", License: "MIT"}},
+				Completions: &models.CompletionSettings{Enabled: true, ManPages: true},
+			}}
+			yaml, err := generator.GenerateGoReleaserConfig(project, config)
+			if err != nil {
+				t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+			}
+			spec, err := ParseSpec([]byte(yaml))
+			if err != nil {
+				t.Fatalf("ParseSpec failed: %v", err)
+			}
+			artifacts, err := FakeArtifacts(project, generator.ArchiveSettingsFor(config))
+			if err != nil {
+				t.Fatalf("FakeArtifacts failed: %v", err)
+			}
+
+			content := Render(spec, artifacts)
+			if problems := Lint(spec, content); len(problems) > 0 {
+				t.Errorf("Lint found problems: %v\n%s", problems, content)
+			}
+
+			want := []string{"class MyTool < Formula", `bash_completion.install "completions/my-tool.bash"`, "test do"}
+			if pkg == "cask" {
+				want = []string{`cask "my-tool" do`, `binary "my-tool"`, `bash_completion "completions/my-tool.bash"`, "postflight do", "com.apple.quarantine"}
+			}
+			want = append(want, "https://github.com/acme/my-tool/releases/download/v1.2.3/my-tool_1.2.3_darwin_arm64.tar.gz")
+			for _, w := range want {
+				if !strings.Contains(content, w) {
+					t.Errorf("Expected %q in\n%s", w, content)
+				}
+			}
+		})
+	}
+}
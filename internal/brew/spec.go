@@ -0,0 +1,141 @@
+// Package brew renders the formula or cask GoReleaser will push to a tap, so it
+// can be checked before anything is published.
+package brew
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the first homebrew_casks or brews entry of a goreleaser config
+type Spec struct {
+	Cask         bool
+	Name         string
+	Description  string
+	Homepage     string
+	License      string
+	Directory    string
+	Caveats      string
+	Dependencies []string
+
+	// Formula only
+	Install     string
+	PostInstall string
+	Test        string
+
+	// Cask only
+	Binaries        []string
+	Completions     map[string]string // bash, zsh, fish
+	Manpages        []string
+	PostInstallHook string
+}
+
+// File is the path of the rendered file inside the tap
+func (s *Spec) File() string {
+	dir := s.Directory
+	if dir == "" && s.Cask {
+		dir = "Casks"
+	} else if dir == "" {
+		dir = "Formula"
+	}
+	return dir + "/" + s.Name + ".rb"
+}
+
+// LoadSpec reads the Homebrew entry of the goreleaser config at path, casks win over brews
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading goreleaser config: %w", err)
+	}
+	return ParseSpec(data)
+}
+
+// ParseSpec extracts the Homebrew entry from goreleaser config content
+func ParseSpec(data []byte) (*Spec, error) {
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing goreleaser config: %w", err)
+	}
+
+	for _, key := range []string{"homebrew_casks", "brews"} {
+		entries, ok := config[key].([]interface{})
+		if !ok || len(entries) == 0 {
+			continue
+		}
+		entry, ok := entries[0].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s entry is not a mapping", key)
+		}
+
+		spec := &Spec{Cask: key == "homebrew_casks"}
+		spec.Name, _ = entry["name"].(string)
+		spec.Description, _ = entry["description"].(string)
+		spec.Homepage, _ = entry["homepage"].(string)
+		spec.License, _ = entry["license"].(string)
+		spec.Directory, _ = entry["directory"].(string)
+		spec.Caveats, _ = entry["caveats"].(string)
+		spec.Install, _ = entry["install"].(string)
+		spec.PostInstall, _ = entry["post_install"].(string)
+		spec.Test, _ = entry["test"].(string)
+		spec.Binaries = stringList(entry["binaries"])
+		if binary, ok := entry["binary"].(string); ok {
+			spec.Binaries = append(spec.Binaries, binary)
+		}
+		spec.Manpages = stringList(entry["manpages"])
+		if manpage, ok := entry["manpage"].(string); ok {
+			spec.Manpages = append(spec.Manpages, manpage)
+		}
+		if completions, ok := entry["completions"].(map[string]interface{}); ok {
+			spec.Completions = map[string]string{}
+			for shell, path := range completions {
+				if path, ok := path.(string); ok {
+					spec.Completions[shell] = path
+				}
+			}
+		}
+		if hooks, ok := entry["hooks"].(map[string]interface{}); ok {
+			if post, ok := hooks["post"].(map[string]interface{}); ok {
+				spec.PostInstallHook, _ = post["install"].(string)
+			}
+		}
+		if deps, ok := entry["dependencies"].([]interface{}); ok {
+			for _, dep := range deps {
+				switch dep := dep.(type) {
+				case map[string]interface{}:
+					if name, ok := dep["name"].(string); ok {
+						spec.Dependencies = append(spec.Dependencies, name)
+					} else if name, ok := dep["formula"].(string); ok {
+						spec.Dependencies = append(spec.Dependencies, name)
+					}
+				case string:
+					spec.Dependencies = append(spec.Dependencies, dep)
+				}
+			}
+		}
+
+		if spec.Name == "" {
+			if name, ok := config["project_name"].(string); ok {
+				spec.Name = name
+			}
+		}
+		if spec.Name == "" {
+			return nil, fmt.Errorf("%s entry has no name and the config has no project_name", key)
+		}
+		return spec, nil
+	}
+
+	return nil, fmt.Errorf("no homebrew_casks or brews section in goreleaser config")
+}
+
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var list []string
+	for _, item := range items {
+		if str, ok := item.(string); ok {
+			list = append(list, str)
+		}
+	}
+	return list
+}
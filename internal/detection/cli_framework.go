@@ -0,0 +1,133 @@
+package detection
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	FrameworkCobra     = "cobra"
+	FrameworkUrfaveCLI = "urfave-cli"
+)
+
+// DetectCLIFramework looks at go.mod for a CLI framework that can generate
+// shell completions. Returns "" if none is required.
+func DetectCLIFramework(projectPath string) string {
+	goModPath := filepath.Join(projectPath, "go.mod")
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return ""
+	}
+
+	modFile, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return ""
+	}
+
+	for _, req := range modFile.Require {
+		switch {
+		case req.Mod.Path == "github.com/spf13/cobra":
+			return FrameworkCobra
+		case req.Mod.Path == "github.com/urfave/cli" || strings.HasPrefix(req.Mod.Path, "github.com/urfave/cli/"):
+			return FrameworkUrfaveCLI
+		}
+	}
+	return ""
+}
+
+// CLICommands are the generator commands the project's CLI really has, frameworks
+// only make them possible: cobra has no man command, urfave/cli v2 no completion command
+type CLICommands struct {
+	Main       string // main package relative to the project, "" if none was found
+	Completion bool   // completion <shell> prints a completion script
+	Man        bool   // man prints a roff man page
+}
+
+// DetectMainPackage finds the main package: the project root, cmd/<binary> or the
+// only main package under cmd/. Returns "" when it can't tell.
+func DetectMainPackage(projectPath, binary string) string {
+	if isMainPackage(projectPath) {
+		return "."
+	}
+	if binary != "" && isMainPackage(filepath.Join(projectPath, "cmd", binary)) {
+		return "./cmd/" + binary
+	}
+	entries, err := os.ReadDir(filepath.Join(projectPath, "cmd"))
+	if err != nil {
+		return ""
+	}
+	found := ""
+	for _, entry := range entries {
+		if !entry.IsDir() || !isMainPackage(filepath.Join(projectPath, "cmd", entry.Name())) {
+			continue
+		}
+		if found != "" {
+			return ""
+		}
+		found = "./cmd/" + entry.Name()
+	}
+	return found
+}
+
+func isMainPackage(dir string) bool {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err == nil && f.Name.Name == "main" {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectCLICommands builds the main package once and asks it for help on the
+// completion and man commands. It takes as long as a go build.
+func DetectCLICommands(projectPath, binary string) CLICommands {
+	commands := CLICommands{Main: DetectMainPackage(projectPath, binary)}
+	if commands.Main == "" {
+		return commands
+	}
+
+	tmp, err := os.MkdirTemp("", "distui-cli-*")
+	if err != nil {
+		return commands
+	}
+	defer os.RemoveAll(tmp)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	bin := filepath.Join(tmp, "cli")
+	build := exec.CommandContext(ctx, "go", "build", "-o", bin, commands.Main)
+	build.Dir = projectPath
+	if err := build.Run(); err != nil {
+		return commands
+	}
+
+	commands.Completion = hasSubcommand(bin, "completion")
+	commands.Man = hasSubcommand(bin, "man")
+	return commands
+}
+
+// hasSubcommand runs "<bin> <name> --help". cobra and urfave/cli fail on unknown
+// commands, and the usage line names the command when it exists.
+func hasSubcommand(bin, name string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, bin, name, "--help").CombinedOutput()
+	if err != nil {
+		return false
+	}
+	return regexp.MustCompile(`(?m)^\s+\S+ ` + name + `\b`).Match(output)
+}
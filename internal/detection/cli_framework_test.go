@@ -0,0 +1,100 @@
+package detection
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetectMainPackage(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name:  "root",
+			files: map[string]string{"main.go": "package main\n"},
+			want:  ".",
+		},
+		{
+			name: "cmd binary",
+			files: map[string]string{
+				"lib.go":           "package tool\n",
+				"cmd/tool/main.go": "package main\n",
+				"cmd/gen/main.go":  "package main\n",
+			},
+			want: "./cmd/tool",
+		},
+		{
+			name:  "only main under cmd",
+			files: map[string]string{"cmd/server/main.go": "package main\n"},
+			want:  "./cmd/server",
+		},
+		{
+			name: "ambiguous",
+			files: map[string]string{
+				"cmd/a/main.go": "package main\n",
+				"cmd/b/main.go": "package main\n",
+			},
+			want: "",
+		},
+		{
+			name:  "library",
+			files: map[string]string{"lib.go": "package tool\n", "main_test.go": "package main\n"},
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+			if got := DetectMainPackage(dir, "tool"); got != tt.want {
+				t.Errorf("DetectMainPackage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectCLICommands(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/tool\n\ngo 1.21\n",
+		"cmd/tool/main.go": `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "completion" {
+		fmt.Println("Usage:\n  tool completion [bash|zsh|fish]")
+		return
+	}
+	fmt.Fprintln(os.Stderr, "unknown command")
+	os.Exit(1)
+}
+`,
+	})
+
+	got := DetectCLICommands(dir, "tool")
+	want := CLICommands{Main: "./cmd/tool", Completion: true, Man: false}
+	if got != want {
+		t.Errorf("DetectCLICommands() = %+v, want %+v", got, want)
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
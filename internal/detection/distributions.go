@@ -3,8 +3,11 @@ package detection
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os/exec"
 	"strings"
+	"time"
 )
 
 type DistributionInfo struct {
@@ -30,17 +33,22 @@ func VerifyHomebrewFormula(tap, formula string) (*DistributionInfo, error) {
 				Stable string `json:"stable"`
 			} `json:"versions"`
 		} `json:"formulae"`
+		// Casks carry their version directly
+		Casks []struct {
+			Version string `json:"version"`
+		} `json:"casks"`
 	}
 
 	if err := json.Unmarshal(output, &result); err != nil {
 		return nil, fmt.Errorf("parsing brew info: %w", err)
 	}
 
-	if len(result.Formulae) == 0 {
-		return &DistributionInfo{Exists: false}, nil
+	var version string
+	if len(result.Formulae) > 0 {
+		version = result.Formulae[0].Versions.Stable
+	} else if len(result.Casks) > 0 {
+		version = result.Casks[0].Version
 	}
-
-	version := result.Formulae[0].Versions.Stable
 	if version == "" {
 		return &DistributionInfo{Exists: false}, nil
 	}
@@ -73,6 +81,85 @@ func VerifyNPMPackage(packageName string) (*DistributionInfo, error) {
 	}, nil
 }
 
+// VerifyScoopManifest reads the manifest straight from the bucket repo on GitHub,
+// so it works without scoop installed
+func VerifyScoopManifest(bucketRepo, manifest string) (*DistributionInfo, error) {
+	if bucketRepo == "" || manifest == "" {
+		return &DistributionInfo{Exists: false}, nil
+	}
+
+	var output []byte
+	for _, dir := range []string{"bucket/", ""} {
+		cmd := exec.Command("gh", "api", "-H", "Accept: application/vnd.github.raw",
+			fmt.Sprintf("repos/%s/contents/%s%s.json", bucketRepo, dir, manifest))
+		if out, err := cmd.Output(); err == nil {
+			output = out
+			break
+		}
+	}
+	if output == nil {
+		return &DistributionInfo{Exists: false}, nil
+	}
+
+	var result struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("parsing scoop manifest: %w", err)
+	}
+
+	if result.Version == "" {
+		return &DistributionInfo{Exists: false}, nil
+	}
+
+	return &DistributionInfo{
+		Version: ensureVPrefix(result.Version),
+		Exists:  true,
+	}, nil
+}
+
+// VerifyAURPackage looks the package up through the AUR RPC interface
+func VerifyAURPackage(packageName string) (*DistributionInfo, error) {
+	if packageName == "" {
+		return &DistributionInfo{Exists: false}, nil
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get("https://aur.archlinux.org/rpc/v5/info?arg[]=" + url.QueryEscape(packageName))
+	if err != nil {
+		return nil, fmt.Errorf("querying AUR: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("querying AUR: %s", resp.Status)
+	}
+
+	var result struct {
+		Results []struct {
+			Version string `json:"Version"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("parsing AUR info: %w", err)
+	}
+
+	if len(result.Results) == 0 || result.Results[0].Version == "" {
+		return &DistributionInfo{Exists: false}, nil
+	}
+
+	// Drop the pkgrel suffix, 1.2.3-1 -> 1.2.3
+	version := result.Results[0].Version
+	if idx := strings.LastIndex(version, "-"); idx > 0 {
+		version = version[:idx]
+	}
+
+	return &DistributionInfo{
+		Version: ensureVPrefix(version),
+		Exists:  true,
+	}, nil
+}
+
 func ensureVPrefix(version string) string {
 	if version == "" {
 		return ""
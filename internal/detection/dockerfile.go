@@ -0,0 +1,38 @@
+package detection
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// DockerfileInfo describes an existing Dockerfile in the project
+type DockerfileInfo struct {
+	Path             string // Relative to the project
+	BuildsFromSource bool   // Compiles Go itself, GoReleaser only hands over the built binary
+}
+
+var dockerfileCandidates = []string{
+	"goreleaser.Dockerfile",
+	"Dockerfile.goreleaser",
+	"Dockerfile",
+	"build/Dockerfile",
+	"docker/Dockerfile",
+}
+
+var goBuildStageRe = regexp.MustCompile(`(?im)^\s*(FROM\s+\S*golang\S*|RUN\s+.*\bgo\s+(build|install)\b)`)
+
+// DetectDockerfile returns the first Dockerfile found in the usual places, nil if none
+func DetectDockerfile(projectPath string) *DockerfileInfo {
+	for _, candidate := range dockerfileCandidates {
+		data, err := os.ReadFile(filepath.Join(projectPath, candidate))
+		if err != nil {
+			continue
+		}
+		return &DockerfileInfo{
+			Path:             candidate,
+			BuildsFromSource: goBuildStageRe.Match(data),
+		}
+	}
+	return nil
+}
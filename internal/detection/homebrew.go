@@ -1,6 +1,7 @@
 package detection
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -12,6 +13,7 @@ type TapInfo struct {
 	Path     string
 	RepoURL  string
 	Formulas []string
+	Casks    []string
 	Exists   bool
 }
 
@@ -55,25 +57,79 @@ func checkTapLocation(path string) (*TapInfo, bool) {
 		return nil, false
 	}
 
-	formulaDir := filepath.Join(path, "Formula")
-	formulas := []string{}
+	repoURL := getRemoteURL(path)
+
+	return &TapInfo{
+		Path:     path,
+		RepoURL:  repoURL,
+		Formulas: rubyFiles(filepath.Join(path, "Formula")),
+		Casks:    rubyFiles(filepath.Join(path, "Casks")),
+		Exists:   true,
+	}, true
+}
 
-	if entries, err := os.ReadDir(formulaDir); err == nil {
+func rubyFiles(dir string) []string {
+	names := []string{}
+	if entries, err := os.ReadDir(dir); err == nil {
 		for _, entry := range entries {
 			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".rb") {
-				formulas = append(formulas, strings.TrimSuffix(entry.Name(), ".rb"))
+				names = append(names, strings.TrimSuffix(entry.Name(), ".rb"))
 			}
 		}
 	}
+	return names
+}
 
-	repoURL := getRemoteURL(path)
+// FindTapCheckout returns the local clone of tapRepo (owner/homebrew-name), trying
+// tapPath first and then the usual tap locations. nil if there is none.
+func FindTapCheckout(tapRepo, tapPath string) *TapInfo {
+	_, repoName, ok := strings.Cut(tapRepo, "/")
+	if !ok || repoName == "" {
+		return nil
+	}
 
-	return &TapInfo{
-		Path:     path,
-		RepoURL:  repoURL,
-		Formulas: formulas,
-		Exists:   true,
-	}, true
+	locations := []string{}
+	if tapPath != "" {
+		locations = append(locations, tapPath)
+	}
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		locations = append(locations,
+			filepath.Join(homeDir, repoName),
+			filepath.Join(homeDir, "repos", repoName),
+			filepath.Join(homeDir, "."+repoName),
+		)
+	}
+
+	for _, loc := range locations {
+		info, exists := checkTapLocation(loc)
+		if !exists {
+			continue
+		}
+		// Only trust a checkout whose origin is the tap, or the configured path
+		if loc == tapPath || strings.Contains(strings.TrimSuffix(info.RepoURL, ".git"), tapRepo) {
+			return info
+		}
+	}
+	return nil
+}
+
+// HasFormula reports whether the tap has Formula/<name>.rb
+func (t *TapInfo) HasFormula(name string) bool {
+	return containsName(t.Formulas, name)
+}
+
+// HasCask reports whether the tap has Casks/<name>.rb
+func (t *TapInfo) HasCask(name string) bool {
+	return containsName(t.Casks, name)
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
 }
 
 func getRemoteURL(path string) string {
@@ -86,19 +142,62 @@ func getRemoteURL(path string) string {
 	return strings.TrimSpace(string(output))
 }
 
-func findTapWithGH(username string) *TapInfo {
-	cmd := exec.Command("gh", "repo", "list", username, "--json", "name,url", "--limit", "100")
+// GitHubTap is a homebrew-* repository found on GitHub
+type GitHubTap struct {
+	Name          string `json:"name"`
+	NameWithOwner string `json:"nameWithOwner"`
+	URL           string `json:"url"`
+}
+
+// ListGitHubTaps returns the homebrew-* repositories of owner, read with gh repo list
+func ListGitHubTaps(owner string) ([]GitHubTap, error) {
+	if owner == "" {
+		return nil, fmt.Errorf("owner required")
+	}
+	cmd := exec.Command("gh", "repo", "list", owner, "--json", "name,nameWithOwner,url", "--limit", "1000")
 	output, err := cmd.Output()
 	if err != nil {
-		return nil
+		return nil, fmt.Errorf("listing repositories of %s: %w", owner, err)
 	}
+	return ParseTapRepoList(output)
+}
 
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		if strings.Contains(line, "homebrew-") {
-			return nil
+// ParseTapRepoList keeps the homebrew-* entries of gh repo list JSON output
+func ParseTapRepoList(data []byte) ([]GitHubTap, error) {
+	var repos []GitHubTap
+	if err := json.Unmarshal(data, &repos); err != nil {
+		return nil, fmt.Errorf("parsing gh repo list output: %w", err)
+	}
+	taps := []GitHubTap{}
+	for _, repo := range repos {
+		if strings.HasPrefix(repo.Name, "homebrew-") {
+			taps = append(taps, repo)
 		}
 	}
+	return taps, nil
+}
 
-	return nil
-}
\ No newline at end of file
+// findTapWithGH picks owner/homebrew-tap if it exists, otherwise the first homebrew-* repo
+func findTapWithGH(username string) *TapInfo {
+	taps, err := ListGitHubTaps(username)
+	if err != nil || len(taps) == 0 {
+		return nil
+	}
+
+	tap := taps[0]
+	for _, t := range taps {
+		if t.Name == "homebrew-tap" {
+			tap = t
+			break
+		}
+	}
+	if local := FindTapCheckout(tap.NameWithOwner, ""); local != nil {
+		return local
+	}
+	return &TapInfo{
+		RepoURL:  tap.URL,
+		Formulas: []string{},
+		Casks:    []string{},
+		Exists:   true,
+	}
+}
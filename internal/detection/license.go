@@ -0,0 +1,74 @@
+package detection
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var licenseFiles = []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "LICENCE.md", "COPYING", "COPYING.md"}
+
+var spaceRe = regexp.MustCompile(`\s+`)
+
+// licenseHeadSize is how much of the text is searched for titles, GPLv3 mentions
+// the Lesser and Affero licenses further down
+const licenseHeadSize = 500
+
+// licenseRules are checked in order. Title phrases must appear near the top,
+// body phrases anywhere.
+var licenseRules = []struct {
+	id    string
+	title []string
+	body  []string
+}{
+	{id: "AGPL-3.0-only", title: []string{"gnu affero general public license", "version 3"}},
+	{id: "LGPL-3.0-only", title: []string{"gnu lesser general public license", "version 3"}},
+	{id: "LGPL-2.1-only", title: []string{"gnu lesser general public license", "version 2.1"}},
+	{id: "GPL-3.0-only", title: []string{"gnu general public license", "version 3"}},
+	{id: "GPL-2.0-only", title: []string{"gnu general public license", "version 2"}},
+	{id: "Apache-2.0", title: []string{"apache license", "version 2.0"}},
+	{id: "MPL-2.0", title: []string{"mozilla public license", "2.0"}},
+	{id: "BSD-3-Clause", body: []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{id: "BSD-2-Clause", body: []string{"redistribution and use in source and binary forms"}},
+	{id: "ISC", body: []string{"permission to use, copy, modify, and/or distribute this software for any purpose", "provided that the above copyright notice"}},
+	{id: "0BSD", body: []string{"permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{id: "MIT", body: []string{"permission is hereby granted, free of charge"}},
+	{id: "Unlicense", body: []string{"this is free and unencumbered software released into the public domain"}},
+}
+
+// DetectLicense returns the SPDX identifier of the project's license file, or ""
+func DetectLicense(projectPath string) string {
+	for _, name := range licenseFiles {
+		data, err := os.ReadFile(filepath.Join(projectPath, name))
+		if err != nil {
+			continue
+		}
+		return MatchLicense(string(data))
+	}
+	return ""
+}
+
+// MatchLicense identifies a license text by its characteristic phrases
+func MatchLicense(text string) string {
+	text = strings.TrimSpace(spaceRe.ReplaceAllString(strings.ToLower(text), " "))
+	head := text
+	if len(head) > licenseHeadSize {
+		head = head[:licenseHeadSize]
+	}
+	for _, rule := range licenseRules {
+		if containsAll(head, rule.title) && containsAll(text, rule.body) {
+			return rule.id
+		}
+	}
+	return ""
+}
+
+func containsAll(text string, phrases []string) bool {
+	for _, phrase := range phrases {
+		if !strings.Contains(text, phrase) {
+			return false
+		}
+	}
+	return true
+}
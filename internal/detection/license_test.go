@@ -0,0 +1,49 @@
+package detection
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMatchLicense(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"mit", "MIT License\n\nCopyright (c) 2024 Acme\n\nPermission is hereby granted, free of charge, to any person obtaining a copy", "MIT"},
+		{"apache", "\n                                 Apache License\n                           Version 2.0, January 2004", "Apache-2.0"},
+		{"gpl3 mentions lesser further down", "GNU GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007\n" + strings.Repeat("Everyone is permitted to copy and distribute verbatim copies. ", 20) +
+			"consider it more useful to permit linking proprietary applications with the library. If this is what you want to do, use the GNU Lesser General Public License instead", "GPL-3.0-only"},
+		{"lgpl21", "GNU LESSER GENERAL PUBLIC LICENSE\n Version 2.1, February 1999", "LGPL-2.1-only"},
+		{"bsd3", "Redistribution and use in source and binary forms, with or without\nmodification, are permitted... Neither the name of the copyright holder", "BSD-3-Clause"},
+		{"bsd2", "Redistribution and use in source and binary forms, with or without modification", "BSD-2-Clause"},
+		{"isc", "Permission to use, copy, modify, and/or distribute this software for any\npurpose with or without fee is hereby granted, provided that the above copyright notice", "ISC"},
+		{"0bsd", "Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted.", "0BSD"},
+		{"unknown", "All rights reserved.", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchLicense(tt.text); got != tt.want {
+				t.Errorf("MatchLicense() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectLicense(t *testing.T) {
+	dir := t.TempDir()
+	if got := DetectLicense(dir); got != "" {
+		t.Errorf("DetectLicense without a license file = %q", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "COPYING"), []byte("Mozilla Public License Version 2.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := DetectLicense(dir); got != "MPL-2.0" {
+		t.Errorf("DetectLicense = %q, want MPL-2.0", got)
+	}
+}
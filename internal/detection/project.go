@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"distui/internal/forge"
 	"distui/internal/models"
 	"golang.org/x/mod/modfile"
 )
@@ -51,6 +52,7 @@ func DetectProject(path string) (*models.ProjectInfo, error) {
 			Name:       binaryName,
 			BuildFlags: []string{},
 		},
+		License: DetectLicense(absPath),
 	}, nil
 }
 
@@ -116,38 +118,24 @@ func detectGitRepository(path string) *models.RepositoryInfo {
 	}
 
 	remoteURL := strings.TrimSpace(string(output))
-	owner, name := parseGitRemoteURL(remoteURL)
-
-	defaultBranch := getDefaultBranch(path)
-
-	return &models.RepositoryInfo{
-		Owner:         owner,
-		Name:          name,
-		DefaultBranch: defaultBranch,
-	}
+	info := parseGitRemoteURL(remoteURL)
+	info.DefaultBranch = getDefaultBranch(path)
+	return info
 }
 
-func parseGitRemoteURL(remoteURL string) (owner, name string) {
-	remoteURL = strings.TrimSuffix(remoteURL, ".git")
-
-	if strings.HasPrefix(remoteURL, "git@github.com:") {
-		parts := strings.Split(strings.TrimPrefix(remoteURL, "git@github.com:"), "/")
-		if len(parts) == 2 {
-			return parts[0], parts[1]
-		}
+func parseGitRemoteURL(remoteURL string) *models.RepositoryInfo {
+	remote, err := forge.ParseRemote(remoteURL)
+	if err != nil {
+		return &models.RepositoryInfo{Name: filepath.Base(strings.TrimSuffix(remoteURL, ".git"))}
 	}
 
-	if strings.Contains(remoteURL, "github.com/") {
-		idx := strings.Index(remoteURL, "github.com/")
-		if idx >= 0 {
-			parts := strings.Split(remoteURL[idx+11:], "/")
-			if len(parts) >= 2 {
-				return parts[0], parts[1]
-			}
-		}
+	info := &models.RepositoryInfo{Owner: remote.Owner, Name: remote.Name}
+	// github.com stays implicit so existing configs don't change
+	if remote.Host != forge.DefaultGitHubHost {
+		info.Host = remote.Host
+		info.Forge = string(remote.Kind)
 	}
-
-	return "", filepath.Base(remoteURL)
+	return info
 }
 
 func getDefaultBranch(path string) string {
@@ -232,14 +220,47 @@ func DetectGitHubUsingGH(path string) (*models.RepositoryInfo, error) {
 	}, nil
 }
 
+// FetchRepoDescription returns the GitHub description of owner/name
+func FetchRepoDescription(owner, name string) (string, error) {
+	cmd := exec.Command("gh", "repo", "view", owner+"/"+name, "--json", "description", "--jq", ".description")
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("gh repo view failed: %w", err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
 type UserEnvironment struct {
 	GitName     string
 	GitEmail    string
 	GitHubUser  string
+	GitHubLogins map[string]string // gh logins by host
 	HasGitConfig bool
 	HasGHCLI     bool
 }
 
+// parseGHAuthStatus reads lines like "✓ Logged in to github.com account williavs (keyring)"
+func parseGHAuthStatus(output string) map[string]string {
+	logins := map[string]string{}
+	for _, line := range strings.Split(output, "\n") {
+		_, rest, found := strings.Cut(line, "Logged in to ")
+		fields := strings.Fields(rest)
+		if !found || len(fields) < 3 || fields[1] != "account" {
+			continue
+		}
+		// gh lists the active account of a host first
+		host := strings.ToLower(fields[0])
+		if _, seen := logins[host]; !seen {
+			logins[host] = fields[2]
+		}
+	}
+	return logins
+}
+
 func (u UserEnvironment) HasMinimalRequirements() bool {
 	return u.HasGitConfig && u.GitName != "" && u.GitEmail != ""
 }
@@ -258,30 +279,12 @@ func DetectUserEnvironment() (*UserEnvironment, error) {
 		env.GitEmail = strings.TrimSpace(string(gitEmail))
 	}
 
-	ghUser, ghErr := exec.Command("gh", "auth", "status", "--hostname", "github.com").CombinedOutput()
-	if ghErr == nil {
-		output := string(ghUser)
-		lines := strings.Split(output, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if strings.Contains(line, "Logged in to github.com account") {
-				// Line format: "✓ Logged in to github.com account williavs (keyring)"
-				parts := strings.Fields(line)
-				for i, part := range parts {
-					if part == "account" && i+1 < len(parts) {
-						username := parts[i+1]
-						// Remove parentheses if present
-						if idx := strings.Index(username, "("); idx > 0 {
-							username = username[:idx]
-						}
-						env.GitHubUser = strings.TrimSpace(username)
-						env.HasGHCLI = true
-						return env, nil
-					}
-				}
-			}
-		}
-	}
+	// Every host gh is logged in to, GitHub Enterprise Server included. gh exits non-zero
+	// when any of them has a problem, the others are still listed.
+	ghStatus, _ := exec.Command("gh", "auth", "status").CombinedOutput()
+	env.GitHubLogins = parseGHAuthStatus(string(ghStatus))
+	env.GitHubUser = env.GitHubLogins["github.com"]
+	env.HasGHCLI = len(env.GitHubLogins) > 0
 
 	return env, nil
 }
\ No newline at end of file
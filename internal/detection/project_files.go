@@ -7,14 +7,26 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"distui/internal/models"
 )
 
 type GoReleaserConfig struct {
+	ProjectName string // project_name, GoReleaser's default for package names
 	HasHomebrew bool
 	HomebrewTap string
 	FormulaName string
+	HomebrewCask bool // Published through homebrew_casks rather than brews
 	HasNPM      bool
 	NPMPackage  string
+	HasScoop    bool
+	ScoopBucket string
+	ScoopName   string
+	Winget      *models.WingetConfig // Settings of the first winget entry, nil if none
+	AUR         *models.AURConfig    // Settings of the first aurs entry, nil if none
+	NFPM        *models.NFPMConfig   // Settings of the first nfpms entry, nil if none
+	Docker      *models.DockerConfig // Image and platforms of the dockers entries, nil if none
+	Nix         *models.NixConfig    // Settings of the first nix entry, nil if none
 }
 
 type PackageJSON struct {
@@ -43,9 +55,16 @@ func DetectGoReleaserConfig(projectPath string) (*GoReleaserConfig, error) {
 		return config, err
 	}
 
-	// Check for brews section (Homebrew)
-	if brews, ok := goreleaserConfig["brews"].([]interface{}); ok && len(brews) > 0 {
+	config.ProjectName, _ = goreleaserConfig["project_name"].(string)
+
+	// Check for homebrew_casks and the older brews section (Homebrew)
+	for _, key := range []string{"homebrew_casks", "brews"} {
+		brews, ok := goreleaserConfig[key].([]interface{})
+		if !ok || len(brews) == 0 {
+			continue
+		}
 		config.HasHomebrew = true
+		config.HomebrewCask = key == "homebrew_casks"
 
 		if brew, ok := brews[0].(map[string]interface{}); ok {
 			if repository, ok := brew["repository"].(map[string]interface{}); ok {
@@ -60,6 +79,135 @@ func DetectGoReleaserConfig(projectPath string) (*GoReleaserConfig, error) {
 				config.FormulaName = name
 			}
 		}
+		break
+	}
+
+	// Check for scoops section (Scoop)
+	if scoops, ok := goreleaserConfig["scoops"].([]interface{}); ok && len(scoops) > 0 {
+		config.HasScoop = true
+
+		if scoop, ok := scoops[0].(map[string]interface{}); ok {
+			if repository, ok := scoop["repository"].(map[string]interface{}); ok {
+				if owner, ok := repository["owner"].(string); ok {
+					if name, ok := repository["name"].(string); ok {
+						config.ScoopBucket = owner + "/" + name
+					}
+				}
+			}
+
+			if name, ok := scoop["name"].(string); ok {
+				config.ScoopName = name
+			}
+		}
+	}
+
+	// Check for winget section
+	if wingets, ok := goreleaserConfig["winget"].([]interface{}); ok && len(wingets) > 0 {
+		config.Winget = &models.WingetConfig{Enabled: true}
+
+		if winget, ok := wingets[0].(map[string]interface{}); ok {
+			config.Winget.PackageIdentifier, _ = winget["package_identifier"].(string)
+			config.Winget.Publisher, _ = winget["publisher"].(string)
+			config.Winget.License, _ = winget["license"].(string)
+			config.Winget.ShortDescription, _ = winget["short_description"].(string)
+			if repository, ok := winget["repository"].(map[string]interface{}); ok {
+				if owner, ok := repository["owner"].(string); ok {
+					if name, ok := repository["name"].(string); ok {
+						config.Winget.ForkRepo = owner + "/" + name
+					}
+				}
+			}
+		}
+	}
+
+	// Check for aurs section (Arch User Repository)
+	if aurs, ok := goreleaserConfig["aurs"].([]interface{}); ok && len(aurs) > 0 {
+		config.AUR = &models.AURConfig{Enabled: true}
+
+		if aur, ok := aurs[0].(map[string]interface{}); ok {
+			config.AUR.PackageName, _ = aur["name"].(string)
+			config.AUR.License, _ = aur["license"].(string)
+			if maintainers, ok := aur["maintainers"].([]interface{}); ok && len(maintainers) > 0 {
+				config.AUR.Maintainer, _ = maintainers[0].(string)
+			}
+		}
+	}
+
+	// Check for nfpms section (Linux packages)
+	if nfpms, ok := goreleaserConfig["nfpms"].([]interface{}); ok && len(nfpms) > 0 {
+		config.NFPM = &models.NFPMConfig{Enabled: true}
+
+		if nfpm, ok := nfpms[0].(map[string]interface{}); ok {
+			config.NFPM.Maintainer, _ = nfpm["maintainer"].(string)
+			config.NFPM.Vendor, _ = nfpm["vendor"].(string)
+			config.NFPM.License, _ = nfpm["license"].(string)
+			config.NFPM.Formats = stringList(nfpm["formats"])
+			config.NFPM.Dependencies = stringList(nfpm["dependencies"])
+
+			if contents, ok := nfpm["contents"].([]interface{}); ok {
+				for _, item := range contents {
+					content, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					src, _ := content["src"].(string)
+					dst, _ := content["dst"].(string)
+					kind, _ := content["type"].(string)
+					if strings.HasPrefix(kind, "config") {
+						config.NFPM.ConfigFiles = append(config.NFPM.ConfigFiles, models.NFPMContent{Src: src, Dst: dst})
+					} else if strings.HasPrefix(dst, "/usr/lib/systemd/system/") {
+						config.NFPM.SystemdUnits = append(config.NFPM.SystemdUnits, src)
+					}
+				}
+			}
+
+			if scripts, ok := nfpm["scripts"].(map[string]interface{}); ok {
+				config.NFPM.Scripts.PreInstall, _ = scripts["preinstall"].(string)
+				config.NFPM.Scripts.PostInstall, _ = scripts["postinstall"].(string)
+				config.NFPM.Scripts.PreRemove, _ = scripts["preremove"].(string)
+				config.NFPM.Scripts.PostRemove, _ = scripts["postremove"].(string)
+			}
+		}
+	}
+
+	// Check for nix section (NUR derivation)
+	if nixes, ok := goreleaserConfig["nix"].([]interface{}); ok && len(nixes) > 0 {
+		config.Nix = &models.NixConfig{Enabled: true, Mode: "nur"}
+
+		if nix, ok := nixes[0].(map[string]interface{}); ok {
+			config.Nix.Path, _ = nix["path"].(string)
+			config.Nix.License, _ = nix["license"].(string)
+			config.Nix.Description, _ = nix["description"].(string)
+			if repository, ok := nix["repository"].(map[string]interface{}); ok {
+				if owner, ok := repository["owner"].(string); ok {
+					if name, ok := repository["name"].(string); ok {
+						config.Nix.Repository = owner + "/" + name
+					}
+				}
+			}
+		}
+	}
+
+	// Check for dockers section (container images), one entry per platform
+	if dockers, ok := goreleaserConfig["dockers"].([]interface{}); ok && len(dockers) > 0 {
+		config.Docker = &models.DockerConfig{Enabled: true}
+		for _, d := range dockers {
+			docker, ok := d.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if config.Docker.Image == "" {
+				if templates := stringList(docker["image_templates"]); len(templates) > 0 {
+					config.Docker.Registry, config.Docker.Image = splitImageName(templates[0])
+				}
+				config.Docker.Dockerfile, _ = docker["dockerfile"].(string)
+			}
+			goarch, _ := docker["goarch"].(string)
+			if goarch == "" {
+				goarch = "amd64"
+			}
+			config.Docker.Platforms = append(config.Docker.Platforms, "linux/"+goarch)
+		}
 	}
 
 	// Check for publishers section (NPM)
@@ -79,6 +227,30 @@ func DetectGoReleaserConfig(projectPath string) (*GoReleaserConfig, error) {
 	return config, nil
 }
 
+// splitImageName splits "ghcr.io/acme/tool:tag" into the registry and "acme/tool"
+func splitImageName(image string) (string, string) {
+	if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		image = image[:idx]
+	}
+	registry, name, ok := strings.Cut(image, "/")
+	if !ok || !strings.ContainsAny(registry, ".:") {
+		// No registry host means Docker Hub
+		return "docker.io", image
+	}
+	return registry, name
+}
+
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var list []string
+	for _, item := range items {
+		if str, ok := item.(string); ok {
+			list = append(list, str)
+		}
+	}
+	return list
+}
+
 func DetectPackageJSON(projectPath string) (*PackageJSON, error) {
 	pkgPath := filepath.Join(projectPath, "package.json")
 	if _, err := os.Stat(pkgPath); err != nil {
@@ -108,3 +108,20 @@ builds:
 		})
 	}
 }
+
+func TestParseGHAuthStatus(t *testing.T) {
+	output := `github.com
+  ✓ Logged in to github.com account williavs (keyring)
+  - Active account: true
+  ✓ Logged in to github.com account other (keyring)
+  - Active account: false
+
+ghe.acme.io
+  X Failed to log in to ghe.acme.io account stale (keyring)
+  ✓ Logged in to GHE.acme.io account alice (GH_ENTERPRISE_TOKEN)
+`
+	logins := parseGHAuthStatus(output)
+	if len(logins) != 2 || logins["github.com"] != "williavs" || logins["ghe.acme.io"] != "alice" {
+		t.Errorf("parseGHAuthStatus() = %v", logins)
+	}
+}
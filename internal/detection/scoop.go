@@ -0,0 +1,85 @@
+package detection
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type BucketInfo struct {
+	Path      string
+	RepoURL   string
+	Manifests []string
+	Exists    bool
+}
+
+func DetectScoopBucket(username string) (*BucketInfo, error) {
+	if username == "" {
+		return nil, fmt.Errorf("username required")
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("getting home dir: %w", err)
+	}
+
+	locations := []string{
+		filepath.Join(homeDir, "scoop-bucket"),
+		filepath.Join(homeDir, "repos", "scoop-bucket"),
+		filepath.Join(homeDir, ".scoop-bucket"),
+	}
+
+	for _, loc := range locations {
+		if info, exists := checkBucketLocation(loc); exists {
+			return info, nil
+		}
+	}
+
+	return &BucketInfo{
+		Path:      filepath.Join(homeDir, "scoop-bucket"),
+		RepoURL:   fmt.Sprintf("https://github.com/%s/scoop-bucket", username),
+		Manifests: []string{},
+		Exists:    false,
+	}, nil
+}
+
+func checkBucketLocation(path string) (*BucketInfo, bool) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, false
+	}
+
+	// Scoop reads manifests from bucket/ when present, otherwise the repo root
+	manifests := []string{}
+	for _, dir := range []string{filepath.Join(path, "bucket"), path} {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+				manifests = append(manifests, strings.TrimSuffix(entry.Name(), ".json"))
+			}
+		}
+		if len(manifests) > 0 {
+			break
+		}
+	}
+
+	return &BucketInfo{
+		Path:      path,
+		RepoURL:   getRemoteURL(path),
+		Manifests: manifests,
+		Exists:    true,
+	}, true
+}
+
+// BucketRepo returns the bucket as owner/repo, falling back to owner/scoop-bucket
+func (b *BucketInfo) BucketRepo(username string) string {
+	if b != nil && b.RepoURL != "" {
+		if repo := parseGitRemoteURL(b.RepoURL); repo.Owner != "" {
+			return repo.Owner + "/" + repo.Name
+		}
+	}
+	return username + "/scoop-bucket"
+}
@@ -0,0 +1,89 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"distui/internal/detection"
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// AURKeyPath expands ~ in the configured key path
+func AURKeyPath(config *models.AURConfig) (string, error) {
+	path := config.PrivateKeyPath
+	if path == "" {
+		path = generator.DefaultAURKeyPath
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("getting home dir: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	return path, nil
+}
+
+// ValidateAUR checks the package metadata and that the SSH key is usable.
+// ssh refuses private keys other users can read, so catch that before tagging.
+func ValidateAUR(config *models.AURConfig) error {
+	if err := generator.ValidateAURConfig(config); err != nil {
+		return err
+	}
+
+	path, err := AURKeyPath(config)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("aur ssh key %s not found: %w", path, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("aur ssh key %s is a directory", path)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("aur ssh key %s is readable by other users, run: chmod 600 %s", path, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading aur ssh key: %w", err)
+	}
+	if !strings.Contains(string(data), "PRIVATE KEY-----") {
+		return fmt.Errorf("aur ssh key %s is not a private key", path)
+	}
+	return nil
+}
+
+// AURPackageURL is the package page on the AUR
+func AURPackageURL(packageName string) string {
+	return "https://aur.archlinux.org/packages/" + packageName
+}
+
+// VerifyAURPackage checks the AUR has the package at version, GoReleaser pushes the
+// PKGBUILD over ssh and the AUR indexes it on push
+func VerifyAURPackage(config *models.AURConfig, version string) (string, error) {
+	info, err := detection.VerifyAURPackage(config.PackageName)
+	if err != nil {
+		return "", err
+	}
+	if !info.Exists {
+		return "", fmt.Errorf("%s not found on the AUR", config.PackageName)
+	}
+	want := aurVersion(version)
+	if info.Version != want {
+		return "", fmt.Errorf("%s on the AUR is at %s, expected %s", config.PackageName, info.Version, want)
+	}
+	return AURPackageURL(config.PackageName), nil
+}
+
+// aurVersion is version as the AUR reports it, pkgver can't contain dashes so
+// GoReleaser writes them as underscores
+func aurVersion(version string) string {
+	return "v" + strings.ReplaceAll(strings.TrimPrefix(version, "v"), "-", "_")
+}
@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"distui/internal/brew"
+)
+
+// BrewInstalled reports whether brew is on PATH
+func BrewInstalled() bool {
+	_, err := exec.LookPath("brew")
+	return err == nil
+}
+
+// AuditBrewFile puts the rendered formula or cask into a throwaway local tap and
+// runs brew audit on it. The tap is removed again afterwards.
+func AuditBrewFile(ctx context.Context, spec *brew.Spec, content string) (string, error) {
+	tap := fmt.Sprintf("distui/audit%d", os.Getpid())
+	brewCmd := func(args ...string) *exec.Cmd {
+		cmd := exec.CommandContext(ctx, "brew", args...)
+		cmd.Env = append(os.Environ(), "HOMEBREW_NO_AUTO_UPDATE=1", "HOMEBREW_NO_ANALYTICS=1", "HOMEBREW_NO_ENV_HINTS=1")
+		return cmd
+	}
+
+	if output, err := brewCmd("tap-new", "--no-git", tap).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("creating temporary tap: %s", lastLine(string(output), err))
+	}
+	defer brewCmd("untap", "--force", tap).Run()
+
+	repo, err := brewCmd("--repo", tap).Output()
+	if err != nil {
+		return "", fmt.Errorf("locating temporary tap: %w", err)
+	}
+
+	path := filepath.Join(strings.TrimSpace(string(repo)), spec.File())
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("creating tap directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("writing %s: %w", spec.File(), err)
+	}
+
+	kind := "--formula"
+	if spec.Cask {
+		kind = "--cask"
+	}
+	output, err := brewCmd("audit", kind, tap+"/"+spec.Name).CombinedOutput()
+	if err != nil {
+		return strings.TrimSpace(string(output)), fmt.Errorf("brew audit found problems")
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,52 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"distui/internal/generator"
+	"distui/internal/github"
+	"distui/internal/models"
+)
+
+// ValidateDocker checks the image settings, the Dockerfile and that buildx can run
+func ValidateDocker(projectPath string, config *models.DockerConfig) error {
+	if err := generator.ValidateDockerConfig(config); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(projectPath, config.Dockerfile)); err != nil {
+		return fmt.Errorf("%s not found, generate release files first", config.Dockerfile)
+	}
+	if output, err := exec.Command("docker", "info", "--format", "{{.ServerVersion}}").CombinedOutput(); err != nil {
+		return fmt.Errorf("docker daemon not reachable: %s", lastLine(string(output), err))
+	}
+	if err := exec.Command("docker", "buildx", "version").Run(); err != nil {
+		return fmt.Errorf("docker buildx is required for multi-arch images: %w", err)
+	}
+	return nil
+}
+
+// DockerLogin logs in to the registry. ghcr.io takes a github.com token, it needs the
+// write:packages scope (gh auth refresh -s write:packages), and the account it belongs to
+// as username, which isn't the repo owner when that is an organization. Other registries
+// use the credentials docker already has.
+func DockerLogin(config *models.DockerConfig, token string) error {
+	if config.Registry != generator.DefaultRegistry {
+		return nil
+	}
+
+	user, err := github.NewWithToken(github.DefaultHost, token).User()
+	if err != nil {
+		return fmt.Errorf("docker login %s: %w", config.Registry, err)
+	}
+
+	cmd := exec.Command("docker", "login", config.Registry, "--username", user.Login, "--password-stdin")
+	cmd.Stdin = strings.NewReader(token)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("docker login %s failed: %s", config.Registry, lastLine(string(output), err))
+	}
+	return nil
+}
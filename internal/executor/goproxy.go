@@ -0,0 +1,124 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+
+	"distui/internal/models"
+)
+
+// DefaultGoProxy is the public module proxy pkg.go.dev indexes from
+const DefaultGoProxy = "https://proxy.golang.org"
+
+// goProxyRetries and goProxyRetryDelay cover the proxy not seeing a freshly pushed tag yet
+var (
+	goProxyRetries    = 3
+	goProxyRetryDelay = 5 * time.Second
+	goProxyClient     = &http.Client{Timeout: 60 * time.Second}
+)
+
+// GoProxyInfo is the proxy's .info response for a version
+type GoProxyInfo struct {
+	Version string    `json:"Version"`
+	Time    time.Time `json:"Time"`
+}
+
+// GoProxyURL is the configured proxy, or proxy.golang.org
+func GoProxyURL(config *models.GoModuleConfig) string {
+	if config == nil || strings.TrimSpace(config.Proxy) == "" {
+		return DefaultGoProxy
+	}
+	return strings.TrimSuffix(strings.TrimSpace(config.Proxy), "/")
+}
+
+// ValidateGoProxy accepts an empty proxy (the default) or a single http(s) URL.
+// GOPROXY lists and direct/off mean nothing for a warm-up request.
+func ValidateGoProxy(proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+	if !strings.HasPrefix(proxy, "https://") && !strings.HasPrefix(proxy, "http://") {
+		return fmt.Errorf("proxy must be an http(s) URL, e.g. %s", DefaultGoProxy)
+	}
+	if strings.ContainsAny(proxy, ",| ") {
+		return fmt.Errorf("proxy must be a single URL, not a GOPROXY list")
+	}
+	return nil
+}
+
+// GoProxyInfoURL is <proxy>/<escaped module>/@v/<version>.info
+func GoProxyInfoURL(proxy, modulePath, version string) (string, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return "", fmt.Errorf("invalid module path %q: %w", modulePath, err)
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("invalid module version %q: %w", version, err)
+	}
+	return strings.TrimSuffix(proxy, "/") + "/" + escapedPath + "/@v/" + escapedVersion + ".info", nil
+}
+
+// WarmGoProxy asks the proxy for the version so it fetches and caches it now, instead of
+// whenever the first `go install ...@latest` happens to. The response must name the version.
+func WarmGoProxy(proxy, modulePath, version string) (*GoProxyInfo, error) {
+	url, err := GoProxyInfoURL(proxy, modulePath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < goProxyRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(goProxyRetryDelay)
+		}
+		info, retry, err := fetchGoProxyInfo(url)
+		if err == nil {
+			if info.Version != version {
+				return nil, fmt.Errorf("proxy answered with version %s, expected %s", info.Version, version)
+			}
+			return info, nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// fetchGoProxyInfo reports whether a failure is worth retrying: the proxy answers 404/410
+// until it can see the tag, and 5xx when the origin is slow
+func fetchGoProxyInfo(url string) (*GoProxyInfo, bool, error) {
+	resp, err := goProxyClient.Get(url)
+	if err != nil {
+		return nil, true, fmt.Errorf("requesting %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, true, fmt.Errorf("reading proxy response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone || resp.StatusCode >= 500
+		return nil, retry, fmt.Errorf("proxy returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var info GoProxyInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, false, fmt.Errorf("parsing proxy response: %w", err)
+	}
+	return &info, false, nil
+}
+
+// PkgGoDevURL is the documentation page pkg.go.dev builds once the proxy has the version
+func PkgGoDevURL(modulePath, version string) string {
+	return "https://pkg.go.dev/" + modulePath + "@" + version
+}
@@ -0,0 +1,67 @@
+package executor
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWarmGoProxy(t *testing.T) {
+	goProxyRetryDelay = time.Millisecond
+	defer func() { goProxyRetryDelay = 5 * time.Second }()
+
+	misses := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/!acme/tool/v2/@v/v2.1.0.info":
+			// A freshly pushed tag isn't visible on the first request
+			if misses == 0 {
+				misses++
+				http.Error(w, "not found: unknown revision v2.1.0", http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, `{"Version":"v2.1.0","Time":"2026-01-02T03:04:05Z"}`)
+		case "/github.com/!acme/tool/v2/@v/v2.2.0.info":
+			fmt.Fprint(w, `{"Version":"v2.1.0","Time":"2026-01-02T03:04:05Z"}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer proxy.Close()
+
+	tests := []struct {
+		name    string
+		version string
+		wantErr bool
+	}{
+		{"cached after a retry", "v2.1.0", false},
+		{"wrong version in response", "v2.2.0", true},
+		{"unknown version", "v9.9.9", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := WarmGoProxy(proxy.URL, "github.com/Acme/tool/v2", tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WarmGoProxy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && info.Version != tt.version {
+				t.Errorf("version = %s", info.Version)
+			}
+		})
+	}
+}
+
+func TestValidateGoProxy(t *testing.T) {
+	for proxy, wantErr := range map[string]bool{
+		"":                             false,
+		"https://goproxy.example.com":  false,
+		"goproxy.example.com":          true,
+		"https://a.example.com,direct": true,
+	} {
+		if err := ValidateGoProxy(proxy); (err != nil) != wantErr {
+			t.Errorf("ValidateGoProxy(%q) error = %v, wantErr %v", proxy, err, wantErr)
+		}
+	}
+}
@@ -9,6 +9,11 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/forge"
+	"distui/internal/generator"
+	"distui/internal/github"
+	"distui/internal/goreleaser"
 )
 
 func CheckGoReleaserInstalled() bool {
@@ -24,20 +29,40 @@ func CheckGoReleaserInstalled() bool {
 	return cmd.Run() == nil
 }
 
+// GetGitHubToken is the github.com token, from the environment or gh
 func GetGitHubToken() (string, error) {
-	cmd := exec.Command("gh", "auth", "token")
-	output, err := cmd.Output()
+	return github.Token(github.DefaultHost)
+}
+
+// ReleaseForge is the forge GoReleaser releases to, from the project's origin. Projects
+// without a recognisable origin keep releasing to GitHub.
+func ReleaseForge(projectPath string) forge.Forge {
+	f, _, err := forge.Detect(projectPath)
 	if err != nil {
-		return "", fmt.Errorf("getting GitHub token: %w", err)
+		return forge.NewGitHub("")
 	}
+	return f
+}
 
-	// Trim whitespace and newlines from token
-	token := strings.TrimSpace(string(output))
-	if token == "" {
-		return "", fmt.Errorf("GitHub token is empty")
+// forgeTokenEnvs are the variables GoReleaser picks its client from, it refuses to run with more than one
+var forgeTokenEnvs = []string{"GITHUB_TOKEN", "GITLAB_TOKEN", "GITEA_TOKEN"}
+
+// releaseEnv is the environment with only the release forge's token set
+func releaseEnv(tokenEnv, token string) []string {
+	var env []string
+	for _, entry := range os.Environ() {
+		name, _, _ := strings.Cut(entry, "=")
+		skip := false
+		for _, tokenVar := range forgeTokenEnvs {
+			if name == tokenVar {
+				skip = true
+			}
+		}
+		if !skip {
+			env = append(env, entry)
+		}
 	}
-
-	return token, nil
+	return append(env, tokenEnv+"="+strings.TrimSpace(token))
 }
 
 func RunGoReleaser(ctx context.Context, projectPath string, version string) tea.Cmd {
@@ -46,14 +71,15 @@ func RunGoReleaser(ctx context.Context, projectPath string, version string) tea.
 			return fmt.Errorf("goreleaser not installed - install from https://goreleaser.com")
 		}
 
-		token, err := GetGitHubToken()
+		f := ReleaseForge(projectPath)
+		token, err := f.Token()
 		if err != nil {
-			return fmt.Errorf("getting GitHub token: %w", err)
+			return fmt.Errorf("getting %s token: %w", f.Kind(), err)
 		}
 		// Ensure token is trimmed
 		token = strings.TrimSpace(token)
 
-		os.Setenv("GITHUB_TOKEN", token)
+		os.Setenv(f.TokenEnv(), token)
 
 		// Try goreleaser in PATH first, then ~/go/bin
 		goreleaserCmd := "goreleaser"
@@ -61,57 +87,57 @@ func RunGoReleaser(ctx context.Context, projectPath string, version string) tea.
 			goreleaserCmd = os.Getenv("HOME") + "/go/bin/goreleaser"
 		}
 
-		return RunCommandStreaming(ctx, goreleaserCmd, []string{"release", "--clean"}, projectPath)()
+		return RunCommandStreaming(ctx, goreleaserCmd, append([]string{"release", "--clean"}, configArgs(projectPath)...), projectPath)()
 	}
 }
 
-func RunGoReleaserWithOutput(ctx context.Context, projectPath string, version string, outputChan chan<- string, changelog string) tea.Cmd {
+// RunGoReleaserWithOutput runs goreleaser release with the release forge's token and
+// extraEnv, which only GoReleaser sees
+func RunGoReleaserWithOutput(ctx context.Context, projectPath string, version string, outputChan chan<- string, changelog string, extraEnv []string) tea.Cmd {
 	return func() tea.Msg {
 		if !CheckGoReleaserInstalled() {
 			return fmt.Errorf("goreleaser not installed - install from https://goreleaser.com")
 		}
 
-		token, err := GetGitHubToken()
+		f := ReleaseForge(projectPath)
+		token, err := f.Token()
 		if err != nil {
-			return fmt.Errorf("getting GitHub token: %w", err)
+			return fmt.Errorf("getting %s token: %w", f.Kind(), err)
 		}
 		// Ensure token is trimmed
 		token = strings.TrimSpace(token)
 
-		// Try goreleaser in PATH first, then ~/go/bin
-		goreleaserCmd := "goreleaser"
-		if _, err := exec.LookPath("goreleaser"); err != nil {
-			goreleaserCmd = os.Getenv("HOME") + "/go/bin/goreleaser"
-		}
+		goreleaserCmd := goreleaserCommand()
+		env := append(releaseEnv(f.TokenEnv(), token), extraEnv...)
 
-		// First, run a check to see if config has fatal errors (not deprecations)
-		checkCmd := exec.Command(goreleaserCmd, "check")
-		checkCmd.Dir = projectPath
-		checkCmd.Env = append(os.Environ(), "GITHUB_TOKEN="+strings.TrimSpace(token))
-		if checkOutput, checkErr := checkCmd.CombinedOutput(); checkErr != nil {
-			outputStr := string(checkOutput)
-			// Only fail on actual errors, not deprecation warnings
-			if !strings.Contains(outputStr, "configuration is valid, but uses deprecated properties") {
-				// Extract meaningful error from check output
-				lines := strings.Split(outputStr, "\n")
-				for _, line := range lines {
-					if strings.Contains(strings.ToLower(line), "error") &&
-					   !strings.Contains(line, "deprecated") &&
-					   !strings.Contains(line, "configuration is valid") {
-						return fmt.Errorf("configuration error: %s", strings.TrimSpace(line))
-					}
-					if strings.Contains(line, "✗") ||
-					   strings.Contains(strings.ToLower(line), "invalid") {
-						return fmt.Errorf("configuration error: %s", strings.TrimSpace(line))
-					}
+		// The embedded schema explains mistakes with positions, goreleaser check decides
+		issues, err := validateGoReleaserConfigFile(projectPath)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if outputChan != nil && issue.Severity == goreleaser.SeverityWarning {
+				select {
+				case outputChan <- "⚠ " + issue.String():
+				default:
 				}
 			}
-			// If we get here with checkErr but no specific error found,
-			// it's probably just deprecation warnings - continue
+		}
+		if outputChan != nil && goreleaser.HasFixable(issues) {
+			select {
+			case outputChan <- "⚠ Deprecated goreleaser keys - press [u] in Configure to upgrade them":
+			default:
+			}
+		}
+		if err := checkGoReleaserConfig(goreleaserCmd, projectPath, env); err != nil {
+			if errs := goreleaser.Errors(issues); len(errs) > 0 {
+				return fmt.Errorf("%w (schema: %s)", err, errs[0].String())
+			}
+			return err
 		}
 
 		// Create the actual release command
-		args := []string{"release", "--clean"}
+		args := append([]string{"release", "--clean"}, configArgs(projectPath)...)
 
 		// Add release notes if changelog is provided
 		if changelog != "" {
@@ -126,7 +152,7 @@ func RunGoReleaserWithOutput(ctx context.Context, projectPath string, version st
 
 		cmd := exec.Command(goreleaserCmd, args...)
 		cmd.Dir = projectPath
-		cmd.Env = append(os.Environ(), "GITHUB_TOKEN="+strings.TrimSpace(token))
+		cmd.Env = env
 
 		// Get stdout and stderr pipes
 		stdout, err := cmd.StdoutPipe()
@@ -295,20 +321,71 @@ func formatGoReleaserOutput(line string) string {
 	return strings.TrimSpace(line)
 }
 
+// ValidateGoReleaserConfig runs goreleaser check when goreleaser is installed, it knows
+// every key of its version. Without it the embedded schema decides. Only errors fail,
+// deprecations are left to the caller.
 func ValidateGoReleaserConfig(projectPath string) error {
-	if !CheckGoReleaserInstalled() {
-		return fmt.Errorf("goreleaser not installed")
+	issues, err := validateGoReleaserConfigFile(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if CheckGoReleaserInstalled() {
+		return checkGoReleaserConfig(goreleaserCommand(), projectPath, os.Environ())
 	}
 
-	cmd := exec.Command("goreleaser", "check")
+	errs := goreleaser.Errors(issues)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	lines := make([]string, len(errs))
+	for i, issue := range errs {
+		lines[i] = "  " + issue.String()
+	}
+	return fmt.Errorf("goreleaser config invalid:\n%s", strings.Join(lines, "\n"))
+}
+
+// checkGoReleaserConfig runs goreleaser check and fails on errors, not on deprecations
+func checkGoReleaserConfig(goreleaserCmd, projectPath string, env []string) error {
+	cmd := exec.Command(goreleaserCmd, append([]string{"check"}, configArgs(projectPath)...)...)
 	cmd.Dir = projectPath
+	cmd.Env = env
 	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	outputStr := string(output)
+	if strings.Contains(outputStr, "configuration is valid, but uses deprecated properties") {
+		return nil
+	}
+	for _, line := range strings.Split(outputStr, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.Contains(line, "deprecated") || strings.Contains(line, "configuration is valid") {
+			continue
+		}
+		if strings.Contains(strings.ToLower(line), "error") || strings.Contains(line, "⨯") ||
+			strings.Contains(strings.ToLower(line), "invalid") {
+			return fmt.Errorf("configuration error: %s", line)
+		}
+	}
+	return fmt.Errorf("goreleaser check failed: %w", err)
+}
 
-	if err != nil {
-		return fmt.Errorf("goreleaser config invalid: %s", string(output))
+// goreleaserCommand is goreleaser from PATH, else the one go install puts in ~/go/bin
+func goreleaserCommand() string {
+	if _, err := exec.LookPath("goreleaser"); err != nil {
+		return os.Getenv("HOME") + "/go/bin/goreleaser"
 	}
+	return "goreleaser"
+}
 
-	return nil
+func validateGoReleaserConfigFile(projectPath string) ([]goreleaser.Issue, error) {
+	configPath := generator.FindGoReleaserConfig(projectPath)
+	if configPath == "" {
+		return nil, fmt.Errorf(".goreleaser.yml not found")
+	}
+	return goreleaser.ValidateFile(configPath)
 }
 
 func RunGoReleaserSnapshot(ctx context.Context, projectPath string) tea.Cmd {
@@ -317,23 +394,19 @@ func RunGoReleaserSnapshot(ctx context.Context, projectPath string) tea.Cmd {
 			return fmt.Errorf("goreleaser not installed")
 		}
 
-		return RunCommandStreaming(ctx, "goreleaser", []string{"release", "--snapshot", "--clean", "--skip=publish"}, projectPath)()
+		return RunCommandStreaming(ctx, "goreleaser", append([]string{"release", "--snapshot", "--clean", "--skip=publish"}, configArgs(projectPath)...), projectPath)()
 	}
 }
 
 func CheckGoReleaserConfigExists(projectPath string) bool {
-	configFiles := []string{
-		".goreleaser.yml",
-		".goreleaser.yaml",
-		"goreleaser.yml",
-		"goreleaser.yaml",
-	}
+	return generator.FindGoReleaserConfig(projectPath) != ""
+}
 
-	for _, file := range configFiles {
-		if _, err := os.Stat(projectPath + "/" + file); err == nil {
-			return true
-		}
+// configArgs points goreleaser at the config distui validates and writes. On its own
+// goreleaser prefers .goreleaser.yml when both spellings exist.
+func configArgs(projectPath string) []string {
+	if path := generator.FindGoReleaserConfig(projectPath); path != "" {
+		return []string{"--config", path}
 	}
-
-	return false
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"distui/internal/generator"
+)
+
+// FormulaMigration retires a formula from a local tap checkout once the cask is published
+type FormulaMigration struct {
+	TapPath string // Local clone of the tap
+	TapRepo string // owner/homebrew-tap
+	Name    string
+}
+
+// MigrateFormulaToCask removes Formula/<name>.rb and records the move in
+// tap_migrations.json, brew then moves existing installs over to the cask.
+// Runs after GoReleaser pushed the cask, a tap without it keeps the formula.
+func MigrateFormulaToCask(migration *FormulaMigration) error {
+	git := func(args ...string) error {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = migration.TapPath
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("git %s: %s", args[0], lastLine(string(output), err))
+		}
+		return nil
+	}
+
+	if err := git("pull", "--ff-only"); err != nil {
+		return err
+	}
+
+	formula := filepath.Join("Formula", migration.Name+".rb")
+	if _, err := os.Stat(filepath.Join(migration.TapPath, formula)); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(migration.TapPath, "Casks", migration.Name+".rb")); err != nil {
+		return fmt.Errorf("Casks/%s.rb is not in the tap yet, keeping the formula", migration.Name)
+	}
+
+	if err := git("rm", "-q", formula); err != nil {
+		return err
+	}
+	if err := UpdateTapMigrations(migration.TapPath, migration.Name, generator.TapName(migration.TapRepo)); err != nil {
+		return err
+	}
+	if err := git("add", generator.TapMigrationsFile); err != nil {
+		return err
+	}
+	if err := git("commit", "-q", "-m", migration.Name+": migrate formula to cask"); err != nil {
+		return err
+	}
+	return git("push")
+}
+
+// UpdateTapMigrations points name at tap in the tap's tap_migrations.json, keeping other entries
+func UpdateTapMigrations(tapPath, name, tap string) error {
+	path := filepath.Join(tapPath, generator.TapMigrationsFile)
+	migrations := map[string]string{}
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if err := json.Unmarshal(data, &migrations); err != nil {
+			return fmt.Errorf("parsing %s: %w", generator.TapMigrationsFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("reading %s: %w", generator.TapMigrationsFile, err)
+	}
+
+	migrations[name] = tap
+	data, err = json.MarshalIndent(migrations, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", generator.TapMigrationsFile, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", generator.TapMigrationsFile, err)
+	}
+	return nil
+}
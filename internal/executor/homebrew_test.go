@@ -0,0 +1,28 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUpdateTapMigrations(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tap_migrations.json")
+	if err := os.WriteFile(path, []byte(`{"old": "acme/other"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := UpdateTapMigrations(dir, "tool", "acme/tap"); err != nil {
+		t.Fatalf("UpdateTapMigrations failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "{\n  \"old\": \"acme/other\",\n  \"tool\": \"acme/tap\"\n}\n"
+	if string(data) != want {
+		t.Errorf("tap_migrations.json = %q, want %q", data, want)
+	}
+}
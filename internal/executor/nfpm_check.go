@@ -0,0 +1,153 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+	"distui/internal/pkginspect"
+)
+
+// PackageReport is the result of checking one built Linux package
+type PackageReport struct {
+	File     string
+	Format   string
+	Name     string
+	Version  string
+	Arch     string
+	Problems []string
+}
+
+// Package script names per format, in GoReleaser order: preinstall, postinstall, preremove, postremove.
+// archlinux has a single .INSTALL file with functions for every hook.
+var packageScriptNames = map[string][4]string{
+	"deb":       {"preinst", "postinst", "prerm", "postrm"},
+	"rpm":       {"prein", "postin", "preun", "postun"},
+	"apk":       {"pre-install", "post-install", "pre-deinstall", "post-deinstall"},
+	"archlinux": {"install", "install", "install", "install"},
+}
+
+// CheckLinuxPackages runs a snapshot build and checks the produced packages against the settings
+func CheckLinuxPackages(ctx context.Context, projectPath string, project *models.ProjectInfo, config *models.NFPMConfig) ([]PackageReport, error) {
+	if err := generator.ValidateNFPMConfig(config); err != nil {
+		return nil, err
+	}
+	if !CheckGoReleaserInstalled() {
+		return nil, fmt.Errorf("goreleaser not installed - install from https://goreleaser.com")
+	}
+
+	goreleaserCmd := "goreleaser"
+	if _, err := exec.LookPath("goreleaser"); err != nil {
+		goreleaserCmd = os.Getenv("HOME") + "/go/bin/goreleaser"
+	}
+
+	cmd := exec.CommandContext(ctx, goreleaserCmd, append([]string{"release", "--snapshot", "--clean"}, configArgs(projectPath)...)...)
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("snapshot build failed: %s", lastLine(string(output), err))
+	}
+
+	return InspectLinuxPackages(filepath.Join(projectPath, "dist"), project, config)
+}
+
+// InspectLinuxPackages checks every package in distDir, one report per package
+func InspectLinuxPackages(distDir string, project *models.ProjectInfo, config *models.NFPMConfig) ([]PackageReport, error) {
+	entries, err := os.ReadDir(distDir)
+	if err != nil {
+		return nil, fmt.Errorf("reading dist directory: %w", err)
+	}
+
+	var reports []PackageReport
+	built := map[string]bool{}
+	for _, entry := range entries {
+		format := pkginspect.FormatOf(entry.Name())
+		if entry.IsDir() || format == "" {
+			continue
+		}
+		built[format] = true
+
+		report := PackageReport{File: entry.Name(), Format: format}
+		info, err := pkginspect.Inspect(filepath.Join(distDir, entry.Name()))
+		if err != nil {
+			report.Problems = []string{err.Error()}
+		} else {
+			report.Name, report.Version, report.Arch = info.Name, info.Version, info.Arch
+			report.Problems = checkPackage(info, project, config)
+		}
+		reports = append(reports, report)
+	}
+
+	for _, format := range config.Formats {
+		if !built[format] {
+			reports = append(reports, PackageReport{Format: format, Problems: []string{"no " + format + " package was built"}})
+		}
+	}
+	sort.SliceStable(reports, func(i, j int) bool { return reports[i].Format < reports[j].Format })
+	return reports, nil
+}
+
+func checkPackage(info *pkginspect.Info, project *models.ProjectInfo, config *models.NFPMConfig) []string {
+	var problems []string
+	binary := generator.ProjectName(project)
+
+	if name := generator.NFPMPackageName(project); info.Name != name {
+		problems = append(problems, fmt.Sprintf("package name is %q, expected %q", info.Name, name))
+	}
+	if info.Maintainer != config.Maintainer {
+		problems = append(problems, fmt.Sprintf("maintainer is %q, expected %q", info.Maintainer, config.Maintainer))
+	}
+	if !info.HasFile("/usr/bin/" + binary) {
+		problems = append(problems, "binary missing from /usr/bin/"+binary)
+	}
+	for _, dep := range config.Dependencies {
+		if !info.DependsOn(strings.Fields(dep)[0]) {
+			problems = append(problems, "missing dependency "+dep)
+		}
+	}
+	for _, file := range config.ConfigFiles {
+		switch {
+		case !info.HasFile(file.Dst):
+			problems = append(problems, "config file missing: "+file.Dst)
+		// apk has no notion of config files, apk-tools keeps changed files as .apk-new
+		case info.Format != "apk" && !info.IsConfigFile(file.Dst):
+			problems = append(problems, file.Dst+" is not marked as a config file")
+		}
+	}
+	for _, unit := range config.SystemdUnits {
+		if dst := generator.SystemdUnitDst(unit); !info.HasFile(dst) {
+			problems = append(problems, "systemd unit missing: "+dst)
+		}
+	}
+
+	names := packageScriptNames[info.Format]
+	for i, script := range []string{config.Scripts.PreInstall, config.Scripts.PostInstall, config.Scripts.PreRemove, config.Scripts.PostRemove} {
+		if script != "" && !containsScript(info.Scripts, names[i]) {
+			problems = append(problems, fmt.Sprintf("%s script missing (%s)", names[i], script))
+		}
+	}
+	return problems
+}
+
+func containsScript(scripts []string, name string) bool {
+	for _, script := range scripts {
+		if script == name {
+			return true
+		}
+	}
+	return false
+}
+
+// lastLine returns the last non-empty output line, that's where goreleaser puts the error
+func lastLine(output string, err error) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		return last
+	}
+	return err.Error()
+}
@@ -0,0 +1,73 @@
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+	"distui/internal/pkginspect"
+)
+
+func TestCheckPackage(t *testing.T) {
+	project := &models.ProjectInfo{Binary: &models.BinaryInfo{Name: "tool"}}
+	config := &models.NFPMConfig{
+		Formats:      []string{"deb", "apk"},
+		Maintainer:   "Jane Doe <jane@example.com>",
+		License:      "MIT",
+		Dependencies: []string{"git"},
+		ConfigFiles:  []models.NFPMContent{{Src: "config.yaml", Dst: "/etc/tool/config.yaml"}},
+		SystemdUnits: []string{"packaging/tool.service"},
+		Scripts:      models.NFPMScripts{PostInstall: "packaging/postinstall.sh"},
+	}
+	complete := pkginspect.Info{
+		Name:       "tool",
+		Maintainer: "Jane Doe <jane@example.com>",
+		Depends:    []string{"git"},
+		Files:      []string{"/usr/bin/tool", "/etc/tool/config.yaml", "/usr/lib/systemd/system/tool.service"},
+	}
+
+	tests := []struct {
+		name   string
+		modify func(i *pkginspect.Info)
+		want   []string
+	}{
+		{"deb complete", func(i *pkginspect.Info) {
+			i.Format = "deb"
+			i.ConfigFiles = []string{"/etc/tool/config.yaml"}
+			i.Scripts = []string{"postinst"}
+		}, nil},
+		{"apk has no config files", func(i *pkginspect.Info) {
+			i.Format = "apk"
+			i.Scripts = []string{"post-install"}
+		}, nil},
+		{"deb missing everything", func(i *pkginspect.Info) {
+			i.Format = "deb"
+			i.Name = "other"
+			i.Depends = nil
+			i.Files = []string{"/etc/tool/config.yaml"}
+		}, []string{
+			`package name is "other"`,
+			"binary missing",
+			"missing dependency git",
+			"not marked as a config file",
+			"systemd unit missing",
+			"postinst script missing",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := complete
+			tt.modify(&info)
+			problems := checkPackage(&info, project, config)
+			if len(problems) != len(tt.want) {
+				t.Fatalf("checkPackage() = %v, want %d problems", problems, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(problems[i], want) {
+					t.Errorf("problem %d = %q, want it to contain %q", i, problems[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"distui/internal/generator"
+	"distui/internal/gomod"
+	"distui/internal/models"
+)
+
+var nixFlags = []string{"--extra-experimental-features", "nix-command flakes"}
+
+// NixInstalled reports whether expressions can be evaluated locally
+func NixInstalled() bool {
+	_, err := exec.LookPath("nix")
+	return err == nil
+}
+
+// ValidateNix checks the settings of the selected mode before anything is tagged
+func ValidateNix(projectPath string, config *models.NixConfig) error {
+	if err := generator.ValidateNixConfig(config); err != nil {
+		return err
+	}
+
+	if config.Mode == "flake" {
+		return CheckFlake(projectPath)
+	}
+
+	// GoReleaser hashes the archives with nix-hash and skips the nix pipe without it
+	if _, err := exec.LookPath("nix-hash"); err != nil {
+		return fmt.Errorf("nix-hash not found, GoReleaser needs it to publish to NUR - install nix")
+	}
+	if err := exec.Command("gh", "api", "repos/"+config.Repository, "--jq", ".full_name").Run(); err != nil {
+		return fmt.Errorf("NUR repository %s not found", config.Repository)
+	}
+	return nil
+}
+
+// CheckFlake verifies flake.nix's vendorHash matches go.sum and, with nix installed,
+// that the flake evaluates
+func CheckFlake(projectPath string) error {
+	data, err := os.ReadFile(filepath.Join(projectPath, generator.FlakeFile))
+	if err != nil {
+		return fmt.Errorf("%s not found, generate release files first", generator.FlakeFile)
+	}
+
+	if current, ok := generator.FlakeVendorHash(string(data)); ok {
+		want, err := gomod.VendorHash(projectPath)
+		if err != nil {
+			return fmt.Errorf("computing vendorHash: %w", err)
+		}
+		if current != want {
+			return fmt.Errorf("%s vendorHash is stale after dependency changes, regenerate release files", generator.FlakeFile)
+		}
+	}
+
+	if !NixInstalled() {
+		return nil
+	}
+	args := append(append([]string{}, nixFlags...), "eval", "--raw", ".#default.name")
+	cmd := exec.Command("nix", args...)
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s does not evaluate: %s", generator.FlakeFile, lastLine(string(output), err))
+	}
+	return nil
+}
+
+// VerifyNURPackage checks GoReleaser pushed the derivation for version and, with nix
+// installed, that it evaluates against nixpkgs
+func VerifyNURPackage(config *models.NixConfig, version string) (string, error) {
+	cmd := exec.Command("gh", "api", "-H", "Accept: application/vnd.github.raw", "repos/"+config.Repository+"/contents/"+config.Path)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s not found in %s", config.Path, config.Repository)
+	}
+
+	want := fmt.Sprintf("version = %q", strings.TrimPrefix(version, "v"))
+	if !strings.Contains(string(output), want) {
+		return "", fmt.Errorf("%s in %s is not at %s", config.Path, config.Repository, version)
+	}
+
+	url := "https://github.com/" + config.Repository + "/blob/HEAD/" + config.Path
+	if !NixInstalled() {
+		return url, nil
+	}
+
+	tmp, err := os.MkdirTemp("", "distui-nix-*")
+	if err != nil {
+		return "", fmt.Errorf("creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+	derivation := filepath.Join(tmp, "default.nix")
+	if err := os.WriteFile(derivation, output, 0644); err != nil {
+		return "", fmt.Errorf("writing derivation: %w", err)
+	}
+
+	expr := fmt.Sprintf(`let pkgs = (builtins.getFlake "nixpkgs").legacyPackages.${builtins.currentSystem}; in (pkgs.callPackage %s {}).name`, derivation)
+	args := append(append([]string{}, nixFlags...), "eval", "--impure", "--raw", "--expr", expr)
+	if output, err := exec.Command("nix", args...).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("%s does not evaluate: %s", config.Path, lastLine(string(output), err))
+	}
+	return url, nil
+}
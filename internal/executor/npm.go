@@ -2,18 +2,27 @@ package executor
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"os"
+
+	"distui/internal/generator"
+	"distui/internal/models"
 )
 
 type NPMPublisher struct {
 	projectPath string
 	version     string
 	packageName string
+	registry    string
+	userconfig  string
+	access      string
+	tag         string
+	provenance  bool
 }
 
 func NewNPMPublisher(projectPath, version, packageName string) *NPMPublisher {
@@ -24,10 +33,24 @@ func NewNPMPublisher(projectPath, version, packageName string) *NPMPublisher {
 	}
 }
 
+// npmCommand runs npm against the publisher's registry and userconfig, when set
+func (n *NPMPublisher) npmCommand(args ...string) *exec.Cmd {
+	if n.registry != "" {
+		args = append(args, "--registry", n.registry)
+	}
+	if n.userconfig != "" {
+		args = append(args, "--userconfig", n.userconfig)
+	}
+	return exec.Command("npm", args...)
+}
+
 func (n *NPMPublisher) CheckAuth() error {
-	cmd := exec.Command("npm", "whoami")
+	cmd := n.npmCommand("whoami")
 	_, err := cmd.CombinedOutput()
 	if err != nil {
+		if n.registry != "" {
+			return fmt.Errorf("not authenticated to %s: %w", n.registry, err)
+		}
 		return fmt.Errorf("not authenticated to npm: %w", err)
 	}
 	return nil
@@ -45,8 +68,7 @@ func (n *NPMPublisher) UpdatePackageVersion() error {
 		version = version[1:]
 	}
 
-	versionRegex := regexp.MustCompile(`("version"\s*:\s*)"[^"]*"`)
-	updatedData := versionRegex.ReplaceAll(data, []byte(`$1"`+version+`"`))
+	updatedData := SetPackageVersion(data, version)
 	if err := os.WriteFile(pkgPath, updatedData, 0644); err != nil {
 		return fmt.Errorf("writing updated package.json: %w", err)
 	}
@@ -54,13 +76,40 @@ func (n *NPMPublisher) UpdatePackageVersion() error {
 	return nil
 }
 
+var packageVersionRe = regexp.MustCompile(`("version"\s*:\s*)"[^"]*"`)
+
+// SetPackageVersion sets version in package.json content, along with the pinned platform packages
+func SetPackageVersion(data []byte, version string) []byte {
+	version = strings.TrimPrefix(version, "v")
+	data = packageVersionRe.ReplaceAll(data, []byte(`$1"`+version+`"`))
+	return setPlatformDependencyVersions(data, version)
+}
+
+// CheckPackageVersion makes sure package.json already has the release version
+func (n *NPMPublisher) CheckPackageVersion() error {
+	data, err := os.ReadFile(filepath.Join(n.projectPath, "package.json"))
+	if err != nil {
+		return fmt.Errorf("reading package.json: %w", err)
+	}
+	var pkg struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return fmt.Errorf("parsing package.json: %w", err)
+	}
+	if want := strings.TrimPrefix(n.version, "v"); pkg.Version != want {
+		return fmt.Errorf("package.json has version %s, the release is %s", pkg.Version, want)
+	}
+	return nil
+}
+
 func (n *NPMPublisher) CheckIfPublished() (bool, error) {
 	version := n.version
 	if strings.HasPrefix(version, "v") {
 		version = version[1:]
 	}
 
-	cmd := exec.Command("npm", "view", fmt.Sprintf("%s@%s", n.packageName, version), "version")
+	cmd := n.npmCommand("view", fmt.Sprintf("%s@%s", n.packageName, version), "version")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
@@ -79,12 +128,24 @@ func (n *NPMPublisher) Publish(outputChan chan<- string) error {
 		return fmt.Errorf("checking publish status: %w", err)
 	}
 	if published {
-		msg := fmt.Sprintf("Version %s already published to NPM", n.version)
+		msg := fmt.Sprintf("Version %s already published to %s", n.version, n.registryName())
 		outputChan <- msg
 		return nil
 	}
 
-	cmd := exec.Command("npm", "publish", "--access", "public")
+	access := n.access
+	if access == "" {
+		access = "public"
+	}
+	tag := n.tag
+	if tag == "" {
+		tag = "latest"
+	}
+	args := []string{"publish", "--access", access, "--tag", tag}
+	if n.provenance {
+		args = append(args, "--provenance")
+	}
+	cmd := n.npmCommand(args...)
 	cmd.Dir = n.projectPath
 
 	var stdout, stderr bytes.Buffer
@@ -104,7 +165,7 @@ func (n *NPMPublisher) Publish(outputChan chan<- string) error {
 		return fmt.Errorf("npm publish failed: %w", err)
 	}
 
-	successMsg := fmt.Sprintf("✓ Successfully published %s@%s to NPM", n.packageName, n.version)
+	successMsg := fmt.Sprintf("✓ Successfully published %s@%s to %s", n.packageName, n.version, n.registryName())
 	outputChan <- successMsg
 	return nil
 }
@@ -142,12 +203,58 @@ func (n *NPMPublisher) CommitAndPush() error {
 	return nil
 }
 
-func PublishToNPM(projectPath, version, packageName string, outputChan chan<- string) error {
-	publisher := NewNPMPublisher(projectPath, version, packageName)
+// StagePackage copies package.json with the release version set, plus README and
+// LICENSE, into a temporary directory. The caller removes it after publishing.
+func StagePackage(projectPath, version string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "package.json"))
+	if err != nil {
+		return "", fmt.Errorf("reading package.json: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "distui-npm-")
+	if err != nil {
+		return "", fmt.Errorf("creating staging directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), SetPackageVersion(data, version), 0644); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("writing staged package.json: %w", err)
+	}
+	if err := copyPackageDocs(projectPath, dir); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
 
-	if err := publisher.CheckAuth(); err != nil {
+// copyPackageDocs copies the README and LICENSE npm shows on the package page
+func copyPackageDocs(projectPath, dir string) error {
+	for _, name := range []string{"README.md", "LICENSE", "LICENSE.md"} {
+		if _, err := os.Stat(filepath.Join(projectPath, name)); err == nil {
+			if err := copyStagedFile(filepath.Join(projectPath, name), filepath.Join(dir, name), 0644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// BumpPackageJSON commits package.json with the release version, the pretag mode runs it before tagging
+func BumpPackageJSON(projectPath, version string) error {
+	publisher := NewNPMPublisher(projectPath, version, "")
+	if err := publisher.UpdatePackageVersion(); err != nil {
 		return err
 	}
+	return publisher.CommitAndPush()
+}
+
+// preparePackageJSON gets package.json in the project ready for the publish mode:
+// commit bumps and pushes it now, pretag expects the bump in the tag, staged leaves it alone
+func preparePackageJSON(publisher *NPMPublisher, npm *models.NPMConfig, outputChan chan<- string) error {
+	switch generator.NPMPublishMode(npm) {
+	case generator.NPMPublishStaged:
+		return nil
+	case generator.NPMPublishPreTag:
+		return publisher.CheckPackageVersion()
+	}
 
 	if err := publisher.UpdatePackageVersion(); err != nil {
 		return err
@@ -160,10 +267,29 @@ func PublishToNPM(projectPath, version, packageName string, outputChan chan<- st
 		return fmt.Errorf("committing version bump: %w", err)
 	}
 	outputChan <- "✓ Committed and pushed package.json"
+	return nil
+}
+
+func PublishToNPM(projectPath, version, packageName string, npm *models.NPMConfig, outputChan chan<- string) error {
+	publisher := NewNPMPublisher(projectPath, version, packageName)
 
-	if err := publisher.Publish(outputChan); err != nil {
+	if err := checkRegistriesAuth(npm); err != nil {
 		return err
 	}
 
-	return nil
-}
\ No newline at end of file
+	if err := preparePackageJSON(publisher, npm, outputChan); err != nil {
+		return err
+	}
+
+	dir := projectPath
+	if generator.NPMPublishMode(npm) == generator.NPMPublishStaged {
+		staged, err := StagePackage(projectPath, version)
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(staged)
+		dir = staged
+	}
+
+	return publishToRegistries([]string{dir}, version, npm, outputChan)
+}
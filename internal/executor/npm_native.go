@@ -0,0 +1,177 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// NPMStageDir is where the native packages are assembled, inside GoReleaser's dist
+const NPMStageDir = "dist/npm"
+
+// distArtifact is the part of an entry in dist/artifacts.json we need
+type distArtifact struct {
+	Path   string `json:"path"`
+	GOOS   string `json:"goos"`
+	GOARCH string `json:"goarch"`
+	Type   string `json:"type"`
+}
+
+// DistBinaries maps goos/goarch to the binary GoReleaser built, read from dist/artifacts.json
+func DistBinaries(projectPath string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "dist", "artifacts.json"))
+	if err != nil {
+		return nil, fmt.Errorf("reading GoReleaser artifacts: %w", err)
+	}
+	var artifacts []distArtifact
+	if err := json.Unmarshal(data, &artifacts); err != nil {
+		return nil, fmt.Errorf("parsing dist/artifacts.json: %w", err)
+	}
+
+	binaries := map[string]string{}
+	for _, artifact := range artifacts {
+		if artifact.Type != "Binary" {
+			continue
+		}
+		path := artifact.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(projectPath, path)
+		}
+		binaries[artifact.GOOS+"/"+artifact.GOARCH] = path
+	}
+	return binaries, nil
+}
+
+// StageNativePackages assembles one package per platform from the GoReleaser binaries and
+// the main package from the project's package.json plus the shim. Returns the platform
+// package directories followed by the main package directory, in publish order.
+func StageNativePackages(projectPath string, project *models.ProjectInfo, mainPackage, version string) ([]string, error) {
+	binaries, err := DistBinaries(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stage := filepath.Join(projectPath, NPMStageDir)
+	if err := os.RemoveAll(stage); err != nil {
+		return nil, fmt.Errorf("clearing %s: %w", NPMStageDir, err)
+	}
+
+	binary := generator.ProjectName(project)
+	if project.Binary != nil && project.Binary.Name != "" {
+		binary = project.Binary.Name
+	}
+
+	var dirs []string
+	for _, platform := range generator.NPMPlatforms {
+		src, ok := binaries[platform.GOOS+"/"+platform.GOARCH]
+		if !ok {
+			return nil, fmt.Errorf("GoReleaser built no %s/%s binary for %s", platform.GOOS, platform.GOARCH, generator.NPMPlatformPackage(mainPackage, platform))
+		}
+		dir := filepath.Join(stage, platform.OS+"-"+platform.CPU)
+		manifest := generator.GeneratePlatformPackageJSON(project, mainPackage, version, platform)
+		if err := writeStagedFile(filepath.Join(dir, "package.json"), []byte(manifest), 0644); err != nil {
+			return nil, err
+		}
+		if err := copyStagedFile(src, filepath.Join(dir, generator.NPMBinaryFile(binary, platform)), 0755); err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, dir)
+	}
+
+	// The staged copy always gets the release version, package.json in the repo may not have it
+	mainDir := filepath.Join(stage, "main")
+	manifest, err := os.ReadFile(filepath.Join(projectPath, "package.json"))
+	if err != nil {
+		return nil, fmt.Errorf("reading package.json: %w", err)
+	}
+	if err := writeStagedFile(filepath.Join(mainDir, "package.json"), SetPackageVersion(manifest, version), 0644); err != nil {
+		return nil, err
+	}
+	shim := generator.NPMShim(project, mainPackage)
+	if err := writeStagedFile(filepath.Join(mainDir, "bin", binary+".js"), []byte(shim), 0755); err != nil {
+		return nil, err
+	}
+	if err := copyPackageDocs(projectPath, mainDir); err != nil {
+		return nil, err
+	}
+	return append(dirs, mainDir), nil
+}
+
+// PublishNativeToNPM publishes the platform packages and then the main package, so
+// nothing installs a main package whose optional dependencies don't exist yet
+func PublishNativeToNPM(projectPath string, project *models.ProjectInfo, version, packageName string, npm *models.NPMConfig, outputChan chan<- string) error {
+	publisher := NewNPMPublisher(projectPath, version, packageName)
+
+	if err := checkRegistriesAuth(npm); err != nil {
+		return err
+	}
+
+	if err := preparePackageJSON(publisher, npm, outputChan); err != nil {
+		return err
+	}
+
+	dirs, err := StageNativePackages(projectPath, project, packageName, version)
+	if err != nil {
+		return fmt.Errorf("staging npm packages: %w", err)
+	}
+
+	return publishToRegistries(dirs, version, npm, outputChan)
+}
+
+var platformDependencyRe = regexp.MustCompile(`("[^"]+-(?:linux|darwin|win32)-(?:x64|arm64)"\s*:\s*)"[^"]*"`)
+
+// setPlatformDependencyVersions pins the optional platform packages to version
+func setPlatformDependencyVersions(data []byte, version string) []byte {
+	return platformDependencyRe.ReplaceAll(data, []byte(`$1"`+version+`"`))
+}
+
+func stagedPackageName(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return "", fmt.Errorf("reading staged package.json: %w", err)
+	}
+	var pkg struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return "", fmt.Errorf("parsing staged package.json: %w", err)
+	}
+	return pkg.Name, nil
+}
+
+func writeStagedFile(path string, data []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, mode); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+func copyStagedFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", src, err)
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(dst), err)
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("copying %s: %w", src, err)
+	}
+	return out.Close()
+}
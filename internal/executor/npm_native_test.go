@@ -0,0 +1,146 @@
+package executor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+func TestStageNativePackages(t *testing.T) {
+	dir := t.TempDir()
+	project := &models.ProjectInfo{Binary: &models.BinaryInfo{Name: "tool"}}
+
+	var artifacts []map[string]string
+	for _, platform := range generator.NPMPlatforms {
+		name := "tool"
+		if platform.GOOS == "windows" {
+			name += ".exe"
+		}
+		path := filepath.Join("dist", "tool_"+platform.GOOS+"_"+platform.GOARCH, name)
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, path), []byte(platform.GOOS), 0755); err != nil {
+			t.Fatal(err)
+		}
+		artifacts = append(artifacts, map[string]string{"path": path, "goos": platform.GOOS, "goarch": platform.GOARCH, "type": "Binary"})
+	}
+	artifacts = append(artifacts, map[string]string{"path": "dist/tool_1.0.0_linux_amd64.tar.gz", "goos": "linux", "goarch": "amd64", "type": "Archive"})
+	data, _ := json.Marshal(artifacts)
+	if err := os.WriteFile(filepath.Join(dir, "dist", "artifacts.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name": "tool", "version": "1.0.0"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs, err := StageNativePackages(dir, project, "tool", "v1.0.0")
+	if err != nil {
+		t.Fatalf("StageNativePackages failed: %v", err)
+	}
+	if len(dirs) != len(generator.NPMPlatforms)+1 || filepath.Base(dirs[len(dirs)-1]) != "main" {
+		t.Fatalf("staged %v, want every platform then main", dirs)
+	}
+
+	binary, err := os.Stat(filepath.Join(dir, NPMStageDir, "win32-x64", "bin", "tool.exe"))
+	if err != nil {
+		t.Fatalf("windows binary not staged: %v", err)
+	}
+	if binary.Mode().Perm()&0100 == 0 {
+		t.Errorf("staged binary is not executable: %v", binary.Mode())
+	}
+	if name, _ := stagedPackageName(dirs[0]); name != "tool-linux-x64" {
+		t.Errorf("first package = %s", name)
+	}
+	if _, err := os.Stat(filepath.Join(dirs[len(dirs)-1], "bin", "tool.js")); err != nil {
+		t.Errorf("shim not staged: %v", err)
+	}
+}
+
+func TestSetPlatformDependencyVersions(t *testing.T) {
+	data := []byte(`"optionalDependencies": {
+    "@acme/tool-linux-x64": "1.0.0",
+    "@acme/tool-win32-arm64": "1.0.0",
+    "left-pad": "1.0.0"
+  }`)
+	got := string(setPlatformDependencyVersions(data, "1.1.0"))
+	if !strings.Contains(got, `"@acme/tool-linux-x64": "1.1.0"`) || !strings.Contains(got, `"@acme/tool-win32-arm64": "1.1.0"`) {
+		t.Errorf("platform packages not bumped:\n%s", got)
+	}
+	if !strings.Contains(got, `"left-pad": "1.0.0"`) {
+		t.Errorf("other dependencies changed:\n%s", got)
+	}
+}
+
+func TestStagePackage(t *testing.T) {
+	dir := t.TempDir()
+	original := `{
+  "name": "tool",
+  "version": "0.0.1",
+  "dependencies": {
+    "golang-npm": "^0.0.6"
+  }
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# tool\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stage, err := StagePackage(dir, "v1.4.0")
+	if err != nil {
+		t.Fatalf("StagePackage failed: %v", err)
+	}
+	defer os.RemoveAll(stage)
+
+	if err := NewNPMPublisher(stage, "v1.4.0", "tool").CheckPackageVersion(); err != nil {
+		t.Errorf("staged package.json: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(stage, "README.md")); err != nil {
+		t.Errorf("README not staged: %v", err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "package.json")); string(data) != original {
+		t.Errorf("package.json in the project changed:\n%s", data)
+	}
+	if err := NewNPMPublisher(dir, "v1.4.0", "tool").CheckPackageVersion(); err == nil {
+		t.Error("expected a version mismatch for the unbumped project package.json")
+	}
+}
+
+func TestNPMUserConfig(t *testing.T) {
+	registry := models.NPMRegistry{URL: "https://npm.example.com/", TokenEnv: "VERDACCIO_TOKEN"}
+	got := string(NPMUserConfig([]byte("save-exact=true"), registry))
+	want := "save-exact=true\n//npm.example.com/:_authToken=${VERDACCIO_TOKEN}\n"
+	if got != want {
+		t.Errorf("userconfig = %q, want %q", got, want)
+	}
+
+	t.Setenv("VERDACCIO_TOKEN", "")
+	if _, err := writeNPMUserConfig(registry); err == nil {
+		t.Error("expected an error for an unset token variable")
+	}
+	t.Setenv("VERDACCIO_TOKEN", "secret")
+	t.Setenv("HOME", t.TempDir())
+	path, err := writeNPMUserConfig(registry)
+	if err != nil {
+		t.Fatalf("writeNPMUserConfig failed: %v", err)
+	}
+	defer os.Remove(path)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("userconfig mode = %v", info.Mode().Perm())
+	}
+	if data, _ := os.ReadFile(path); strings.Contains(string(data), "secret") {
+		t.Error("token written to disk")
+	}
+}
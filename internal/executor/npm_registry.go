@@ -0,0 +1,167 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"distui/internal/generator"
+	"distui/internal/models"
+)
+
+// NPMUserConfig is the user's ~/.npmrc plus an auth line for registry that reads the
+// token from its environment variable. npm expands ${VAR} itself, so the token never
+// lands on disk.
+func NPMUserConfig(base []byte, registry models.NPMRegistry) []byte {
+	var b strings.Builder
+	b.Write(base)
+	if len(base) > 0 && !strings.HasSuffix(string(base), "\n") {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%s:_authToken=${%s}\n", generator.NPMRegistryKey(registry.URL), registry.TokenEnv)
+	return []byte(b.String())
+}
+
+// writeNPMUserConfig writes a temporary userconfig for registries authenticated through an
+// environment variable. Registries without one use ~/.npmrc as is and get an empty path.
+func writeNPMUserConfig(registry models.NPMRegistry) (string, error) {
+	if registry.TokenEnv == "" {
+		return "", nil
+	}
+	if os.Getenv(registry.TokenEnv) == "" {
+		return "", fmt.Errorf("$%s is not set, it holds the token for %s", registry.TokenEnv, registry.URL)
+	}
+
+	var base []byte
+	if home, err := os.UserHomeDir(); err == nil {
+		base, _ = os.ReadFile(filepath.Join(home, ".npmrc"))
+	}
+
+	file, err := os.CreateTemp("", "distui-npmrc-")
+	if err != nil {
+		return "", fmt.Errorf("creating npm userconfig: %w", err)
+	}
+	defer file.Close()
+	if err := file.Chmod(0600); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("securing npm userconfig: %w", err)
+	}
+	if _, err := file.Write(NPMUserConfig(base, registry)); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("writing npm userconfig: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// registryPublisher points a publisher at registry with the configured access, dist-tag and
+// provenance. The returned cleanup removes its userconfig.
+func registryPublisher(dir, version, packageName string, registry models.NPMRegistry, npm *models.NPMConfig) (*NPMPublisher, func(), error) {
+	userconfig, err := writeNPMUserConfig(registry)
+	if err != nil {
+		return nil, nil, err
+	}
+	publisher := NewNPMPublisher(dir, version, packageName)
+	publisher.registry = registry.URL
+	publisher.userconfig = userconfig
+	if npm != nil {
+		publisher.access = npm.Access
+		publisher.tag = npm.Tag
+		// npm can only sign provenance from a supported CI and only npmjs accepts it
+		publisher.provenance = npm.Provenance && os.Getenv("GITHUB_ACTIONS") == "true" && generator.IsNPMJS(registry.URL)
+	}
+	cleanup := func() {
+		if userconfig != "" {
+			os.Remove(userconfig)
+		}
+	}
+	return publisher, cleanup, nil
+}
+
+// registryName is how output refers to the publisher's registry
+func (n *NPMPublisher) registryName() string {
+	if n.registry == "" || generator.IsNPMJS(n.registry) {
+		return "NPM"
+	}
+	return n.registry
+}
+
+// checkRegistriesAuth makes sure every registry accepts our credentials before anything is bumped
+func checkRegistriesAuth(npm *models.NPMConfig) error {
+	for _, registry := range generator.NPMRegistries(npm) {
+		publisher, cleanup, err := registryPublisher("", "", "", registry, npm)
+		if err != nil {
+			return err
+		}
+		err = publisher.CheckAuth()
+		cleanup()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishToRegistries publishes the package directories, in order, to every registry.
+// A failing registry doesn't stop the others, its errors are returned together.
+func publishToRegistries(dirs []string, version string, npm *models.NPMConfig, outputChan chan<- string) error {
+	var errs []error
+	for _, registry := range generator.NPMRegistries(npm) {
+		if npm != nil && npm.Provenance && !generator.IsNPMJS(registry.URL) {
+			outputChan <- fmt.Sprintf("Skipping provenance for %s, only npmjs supports it", registry.URL)
+		} else if npm != nil && npm.Provenance && os.Getenv("GITHUB_ACTIONS") != "true" {
+			outputChan <- "Skipping provenance, it can only be generated in GitHub Actions"
+		}
+		if err := publishToRegistry(dirs, version, registry, npm, outputChan); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", registry.URL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func publishToRegistry(dirs []string, version string, registry models.NPMRegistry, npm *models.NPMConfig, outputChan chan<- string) error {
+	for _, dir := range dirs {
+		name, err := stagedPackageName(dir)
+		if err != nil {
+			return err
+		}
+		publisher, cleanup, err := registryPublisher(dir, version, name, registry, npm)
+		if err != nil {
+			return err
+		}
+		err = publisher.Publish(outputChan)
+		cleanup()
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ValidateNPM checks the settings, then that every registry answers and knows who we are
+func ValidateNPM(npm *models.NPMConfig) error {
+	// Older configs leave the name to package.json, only the registry checks apply to them
+	if npm.PackageName != "" {
+		if err := generator.ValidateNPMConfig(npm); err != nil {
+			return fmt.Errorf("npm: %w", err)
+		}
+	}
+	for _, registry := range generator.NPMRegistries(npm) {
+		publisher, cleanup, err := registryPublisher("", "", "", registry, npm)
+		if err != nil {
+			return fmt.Errorf("npm: %w", err)
+		}
+		output, err := publisher.npmCommand("ping").CombinedOutput()
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("npm registry %s unreachable: %s: %w", registry.URL, strings.TrimSpace(string(output)), err)
+		}
+		err = publisher.CheckAuth()
+		cleanup()
+		if err != nil {
+			return fmt.Errorf("npm: %w", err)
+		}
+	}
+	return nil
+}
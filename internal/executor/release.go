@@ -11,7 +11,11 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"distui/internal/forge"
+	"distui/internal/generator"
 	"distui/internal/gitcleanup"
+	"distui/internal/github"
+	"distui/internal/gomod"
 	"distui/internal/models"
 )
 
@@ -25,11 +29,22 @@ type ReleaseConfig struct {
 	SkipTests      bool
 	EnableHomebrew bool
 	EnableNPM      bool
+	EnableScoop    bool
+	Winget         *models.WingetConfig // nil unless winget is enabled
+	AUR            *models.AURConfig    // nil unless aur is enabled
+	Docker         *models.DockerConfig // nil unless container images are enabled
+	Nix            *models.NixConfig    // nil unless nix is enabled
+	NPM            *models.NPMConfig    // nil unless npm is enabled
+	Project        *models.ProjectInfo  // Binary, license and repo, for native npm packages and verification
 	HomebrewTap    string
+	FormulaMigration *FormulaMigration // Set when the tap still has the formula the cask replaces
 	RepoOwner      string
 	RepoName       string
 	ProjectName    string
 	Changelog      string
+	ModulePath     string // New module path to commit before tagging (major version bumps)
+	GoModule       *models.GoModuleConfig // nil unless the go module channel is enabled
+	Module         string                 // Module path the proxy is warmed for
 }
 
 type ExecutionResult struct {
@@ -62,6 +77,7 @@ func (r *ReleaseExecutor) ExecuteReleasePhasesWithOutput(ctx context.Context, ou
 
 		startTime := time.Now()
 		channels := []string{"GitHub"}
+		var links []string
 
 		// Helper to send output if channel is available
 		sendOutput := func(msg string) {
@@ -116,6 +132,26 @@ func (r *ReleaseExecutor) ExecuteReleasePhasesWithOutput(ctx context.Context, ou
 			})
 		}
 
+		// Major version bumps need go.mod to declare the /vN path before tagging
+		if r.config.ModulePath != "" {
+			sendOutput("Updating module path to " + r.config.ModulePath + "...")
+			if err := r.updateModulePath(ctx); err != nil {
+				sendOutput("✗ Module path update failed: " + err.Error())
+				return r.failureResult(startTime, "tag", err, channels)
+			}
+			sendOutput("✓ Module path updated and committed: " + r.config.ModulePath)
+		}
+
+		// The pretag npm mode puts the version into package.json so the tag matches the tarball
+		if r.config.EnableNPM && generator.NPMPublishMode(r.config.NPM) == generator.NPMPublishPreTag {
+			sendOutput("Bumping package.json to " + r.config.Version + "...")
+			if err := BumpPackageJSON(r.projectPath, r.config.Version); err != nil {
+				sendOutput("✗ package.json bump failed: " + err.Error())
+				return r.failureResult(startTime, "tag", err, channels)
+			}
+			sendOutput("✓ package.json bumped and committed")
+		}
+
 		// Create and push tag
 		sendOutput("Creating and pushing tag " + r.config.Version + "...")
 		phaseStart = time.Now()
@@ -130,27 +166,126 @@ func (r *ReleaseExecutor) ExecuteReleasePhasesWithOutput(ctx context.Context, ou
 			success:  true,
 		})
 
+		// The proxy only needs the tag, warming it now gives the release the most time to show up
+		if r.config.GoModule != nil {
+			phaseStart = time.Now()
+			if url, ok := r.warmGoProxy(sendOutput); ok {
+				channels = append(channels, "Go Module")
+				if url != "" {
+					links = append(links, "pkg.go.dev: "+url)
+				}
+			}
+			completedPhases = append(completedPhases, phaseResult{
+				phase:    models.PhaseGoModule,
+				duration: time.Since(phaseStart),
+				success:  true, // A cold proxy doesn't fail the release
+			})
+		}
+
+		// The generated aurs section reads the key path from GoReleaser's environment
+		var extraEnv []string
+		if r.config.AUR != nil {
+			if keyPath, err := AURKeyPath(r.config.AUR); err == nil {
+				extraEnv = append(extraEnv, generator.AURKeyEnv+"="+keyPath)
+			}
+		}
+
 		// Run GoReleaser
 		sendOutput("Running GoReleaser...")
 		phaseStart = time.Now()
-		goreleaserCmd := RunGoReleaserWithOutput(ctx, r.projectPath, r.config.Version, outputChan, r.config.Changelog)
+		goreleaserCmd := RunGoReleaserWithOutput(ctx, r.projectPath, r.config.Version, outputChan, r.config.Changelog, extraEnv)
 		msg := goreleaserCmd()
 		if err, ok := msg.(error); ok {
 			sendOutput("✗ GoReleaser failed: " + err.Error())
 			return r.failureResult(startTime, "goreleaser", err, channels)
 		}
 		sendOutput("✓ GoReleaser completed successfully")
+
+		if f, remote, err := forge.Detect(r.projectPath); err == nil {
+			if exists, err := f.ReleaseExists(remote, r.config.Version); err != nil {
+				sendOutput("⚠ Release not verified: " + err.Error())
+			} else if !exists {
+				sendOutput(fmt.Sprintf("⚠ No %s release found for %s", f.Kind(), r.config.Version))
+			} else {
+				links = append(links, "Release: "+remote.ReleaseURL(r.config.Version))
+			}
+		}
 		completedPhases = append(completedPhases, phaseResult{
 			phase:    models.PhaseGoReleaser,
 			duration: time.Since(phaseStart),
 			success:  true,
 		})
 
-		// Homebrew is handled by GoReleaser's brews configuration
+		// Images and manifests are pushed by GoReleaser's dockers configuration
+		if r.config.Docker != nil {
+			channels = append(channels, "Container")
+			for _, tag := range generator.DockerImageTags(r.config.Docker, r.config.Version) {
+				links = append(links, "Image: "+tag)
+			}
+		}
+
+		// Homebrew is handled by GoReleaser's homebrew_casks or brews configuration
 		if r.config.EnableHomebrew {
 			channels = append(channels, "Homebrew")
 		}
 
+		if r.config.FormulaMigration != nil {
+			sendOutput("Retiring Homebrew formula " + r.config.FormulaMigration.Name + "...")
+			if err := MigrateFormulaToCask(r.config.FormulaMigration); err != nil {
+				sendOutput("⚠ Formula not migrated: " + err.Error())
+			} else {
+				sendOutput("✓ Formula moved to cask, brew migrates existing installs via " + generator.TapMigrationsFile)
+			}
+		}
+
+		// Scoop manifests are pushed by GoReleaser's scoops configuration, check they landed
+		if r.config.EnableScoop {
+			sendOutput("Verifying Scoop manifest...")
+			if manifest, err := VerifyScoopManifest(r.projectPath, r.config.Version, r.binaryName()); err != nil {
+				sendOutput("⚠ Scoop manifest not verified yet: " + err.Error())
+			} else {
+				sendOutput("✓ Scoop manifest updated: " + manifest)
+				channels = append(channels, "Scoop")
+			}
+		}
+
+		// AUR packages are pushed by GoReleaser's aurs configuration, check the AUR has the version
+		if r.config.AUR != nil {
+			sendOutput("Verifying AUR package...")
+			if url, err := VerifyAURPackage(r.config.AUR, r.config.Version); err != nil {
+				sendOutput("⚠ AUR package not verified: " + err.Error())
+			} else {
+				sendOutput("✓ AUR package updated: " + url)
+				channels = append(channels, "AUR")
+				links = append(links, "AUR: "+url)
+			}
+		}
+
+		// Winget manifests go to microsoft/winget-pkgs as a PR from the fork
+		if r.config.Winget != nil {
+			if url, err := FindWingetPR(r.projectPath, r.config.Winget, r.config.Version, r.binaryName()); err != nil {
+				sendOutput("⚠ Winget pull request not found: " + err.Error())
+			} else {
+				sendOutput("✓ Winget manifest PR: " + url)
+				channels = append(channels, "Winget")
+				links = append(links, "Winget PR: "+url)
+			}
+		}
+
+		// NUR derivations are pushed by GoReleaser's nix configuration, check they evaluate
+		if r.config.Nix != nil && r.config.Nix.Mode == "nur" {
+			sendOutput("Verifying NUR derivation...")
+			if url, err := VerifyNURPackage(r.config.Nix, r.config.Version); err != nil {
+				sendOutput("⚠ NUR derivation not verified: " + err.Error())
+			} else {
+				sendOutput("✓ NUR derivation updated: " + url)
+				channels = append(channels, "NUR")
+				links = append(links, "NUR: "+url)
+			}
+		} else if r.config.Nix != nil {
+			channels = append(channels, "Nix flake")
+		}
+
 		// NPM publish runs AFTER GoReleaser (needs the GitHub release to exist)
 		if r.config.EnableNPM {
 			sendOutput("Publishing to NPM...")
@@ -167,7 +302,13 @@ func (r *ReleaseExecutor) ExecuteReleasePhasesWithOutput(ctx context.Context, ou
 				if err := json.Unmarshal(pkgData, &pkg); err == nil {
 					if pkgName, ok := pkg["name"].(string); ok {
 						// Run NPM publish
-						if err := PublishToNPM(r.projectPath, r.config.Version, pkgName, outputChan); err != nil {
+						publish := func() error { return PublishToNPM(r.projectPath, r.config.Version, pkgName, r.config.NPM, outputChan) }
+						if generator.NPMIsNative(r.config.NPM) && r.config.Project != nil {
+							publish = func() error {
+								return PublishNativeToNPM(r.projectPath, r.config.Project, r.config.Version, pkgName, r.config.NPM, outputChan)
+							}
+						}
+						if err := publish(); err != nil {
 							sendOutput("✗ NPM publish failed: " + err.Error())
 							// Don't fail the entire release, NPM is optional
 						} else {
@@ -191,6 +332,7 @@ func (r *ReleaseExecutor) ExecuteReleasePhasesWithOutput(ctx context.Context, ou
 			Version:    r.config.Version,
 			Duration:   time.Since(startTime),
 			Channels:   channels,
+			Links:      links,
 			TotalSteps: r.countSteps(),
 		}
 	}
@@ -226,6 +368,48 @@ func (r *ReleaseExecutor) createAndPushTag(ctx context.Context) error {
 	return nil
 }
 
+func (r *ReleaseExecutor) updateModulePath(ctx context.Context) error {
+	oldPath, err := gomod.ReadModulePath(r.projectPath)
+	if err != nil {
+		return err
+	}
+	if oldPath == r.config.ModulePath {
+		return nil
+	}
+
+	changed, err := gomod.RewriteModulePath(r.projectPath, oldPath, r.config.ModulePath)
+	if err != nil {
+		return err
+	}
+
+	addCmd := RunCommandStreaming(ctx, "git", append([]string{"add", "--"}, changed...), r.projectPath)
+	msg := addCmd()
+	if completeMsg, ok := msg.(models.CommandCompleteMsg); ok {
+		if completeMsg.ExitCode != 0 {
+			return fmt.Errorf("staging module path changes: %w", completeMsg.Error)
+		}
+	}
+
+	commitMsg := fmt.Sprintf("chore: update module path to %s for %s", r.config.ModulePath, r.config.Version)
+	commitCmd := RunCommandStreaming(ctx, "git", []string{"commit", "-m", commitMsg}, r.projectPath)
+	msg = commitCmd()
+	if completeMsg, ok := msg.(models.CommandCompleteMsg); ok {
+		if completeMsg.ExitCode != 0 {
+			return fmt.Errorf("committing module path changes: %w", completeMsg.Error)
+		}
+	}
+
+	pushCmd := RunCommandStreaming(ctx, "git", []string{"push", "origin", "HEAD"}, r.projectPath)
+	msg = pushCmd()
+	if completeMsg, ok := msg.(models.CommandCompleteMsg); ok {
+		if completeMsg.ExitCode != 0 {
+			return fmt.Errorf("pushing module path changes: %w", completeMsg.Error)
+		}
+	}
+
+	return nil
+}
+
 func (r *ReleaseExecutor) failureResult(startTime time.Time, step string, err error, channels []string) models.ReleaseCompleteMsg {
 	return models.ReleaseCompleteMsg{
 		Success:    false,
@@ -248,9 +432,51 @@ func (r *ReleaseExecutor) countSteps() int {
 	if r.config.EnableNPM {
 		steps++
 	}
+	if r.config.GoModule != nil {
+		steps++
+	}
 	return steps
 }
 
+// modulePath is the module the release tags, the new /vN path on major bumps
+func (r *ReleaseExecutor) modulePath() string {
+	if r.config.ModulePath != "" {
+		return r.config.ModulePath
+	}
+	return r.config.Module
+}
+
+// binaryName is the project's binary, GoReleaser's fallback for package names
+func (r *ReleaseExecutor) binaryName() string {
+	if name := generator.ProjectName(r.config.Project); name != "" {
+		return name
+	}
+	return r.config.ProjectName
+}
+
+// warmGoProxy requests the version from the module proxy. Returns the pkg.go.dev page
+// when the public proxy was warmed, since pkg.go.dev only indexes from there.
+func (r *ReleaseExecutor) warmGoProxy(sendOutput func(string)) (string, bool) {
+	modulePath := r.modulePath()
+	if modulePath == "" {
+		sendOutput("⚠ Go module proxy not warmed: no module path in go.mod")
+		return "", false
+	}
+
+	proxy := GoProxyURL(r.config.GoModule)
+	sendOutput("Warming Go module proxy " + proxy + "...")
+	info, err := WarmGoProxy(proxy, modulePath, r.config.Version)
+	if err != nil {
+		sendOutput("⚠ Go module proxy not warmed: " + err.Error())
+		return "", false
+	}
+	sendOutput(fmt.Sprintf("✓ %s@%s cached by %s (%s)", modulePath, info.Version, proxy, info.Time.Format(time.RFC3339)))
+	if proxy != DefaultGoProxy {
+		return "", true
+	}
+	return PkgGoDevURL(modulePath, info.Version), true
+}
+
 func (r *ReleaseExecutor) ValidatePreFlight() error {
 	// Check for uncommitted changes FIRST (block if found)
 	if gitcleanup.HasUncommittedChanges() {
@@ -278,8 +504,55 @@ func (r *ReleaseExecutor) ValidatePreFlight() error {
 		return fmt.Errorf(".goreleaser.yml not found")
 	}
 
-	if _, err := GetGitHubToken(); err != nil {
-		return fmt.Errorf("GitHub authentication: %w", err)
+	// Catch config mistakes before anything is tagged
+	if err := ValidateGoReleaserConfig(r.projectPath); err != nil {
+		return err
+	}
+
+	releaseForge := ReleaseForge(r.projectPath)
+	token, err := releaseForge.Token()
+	if err != nil {
+		return fmt.Errorf("%s authentication: %w", releaseForge.Kind(), err)
+	}
+
+	if r.config.Winget != nil {
+		if err := ValidateWinget(r.config.Winget); err != nil {
+			return err
+		}
+	}
+
+	if r.config.AUR != nil {
+		if err := ValidateAUR(r.config.AUR); err != nil {
+			return err
+		}
+	}
+
+	if r.config.NPM != nil {
+		if err := ValidateNPM(r.config.NPM); err != nil {
+			return err
+		}
+	}
+
+	if r.config.Nix != nil {
+		if err := ValidateNix(r.projectPath, r.config.Nix); err != nil {
+			return err
+		}
+	}
+
+	// Log in before tagging, a rejected push would leave a tag without images
+	if r.config.Docker != nil {
+		if err := ValidateDocker(r.projectPath, r.config.Docker); err != nil {
+			return err
+		}
+		// ghcr.io takes the github.com login even when the project lives elsewhere
+		if r.config.Docker.Registry == generator.DefaultRegistry && (releaseForge.Kind() != forge.GitHub || releaseForge.Host() != github.DefaultHost) {
+			if token, err = GetGitHubToken(); err != nil {
+				return fmt.Errorf("ghcr.io needs a github.com token: %w", err)
+			}
+		}
+		if err := DockerLogin(r.config.Docker, token); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/internal/detection"
+)
+
+// VerifyScoopManifest checks that GoReleaser pushed the manifest for version to the
+// bucket. Bucket and manifest name come from the goreleaser config that was released.
+// It checks once, the post-release verification looks again if the bucket lags behind.
+func VerifyScoopManifest(projectPath, version, binary string) (string, error) {
+	grConfig, err := detection.DetectGoReleaserConfig(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("reading goreleaser config: %w", err)
+	}
+	if !grConfig.HasScoop || grConfig.ScoopBucket == "" {
+		return "", fmt.Errorf("no scoops section with a repository in goreleaser config")
+	}
+	name := scoopManifestName(grConfig, binary)
+	if name == "" {
+		return "", fmt.Errorf("scoops entry has no name and there is no project name to fall back to")
+	}
+
+	info, err := detection.VerifyScoopManifest(grConfig.ScoopBucket, name)
+	if err != nil {
+		return "", err
+	}
+	if !info.Exists {
+		return "", fmt.Errorf("manifest %s.json not found in %s", name, grConfig.ScoopBucket)
+	}
+	if want := "v" + strings.TrimPrefix(version, "v"); info.Version != want {
+		return "", fmt.Errorf("manifest %s.json in %s is at %s, expected %s", name, grConfig.ScoopBucket, info.Version, want)
+	}
+	return grConfig.ScoopBucket + "/" + name, nil
+}
+
+// scoopManifestName is the scoops entry's name, which GoReleaser defaults to project_name,
+// itself defaulting to the project's binary
+func scoopManifestName(grConfig *detection.GoReleaserConfig, binary string) string {
+	switch {
+	case grConfig.ScoopName != "":
+		return grConfig.ScoopName
+	case grConfig.ProjectName != "":
+		return grConfig.ProjectName
+	}
+	return binary
+}
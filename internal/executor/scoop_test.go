@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"testing"
+
+	"distui/internal/detection"
+)
+
+func TestScoopManifestName(t *testing.T) {
+	tests := []struct {
+		name   string
+		config detection.GoReleaserConfig
+		binary string
+		want   string
+	}{
+		{"entry name", detection.GoReleaserConfig{ScoopName: "tool-cli", ProjectName: "tool"}, "tool", "tool-cli"},
+		{"project name", detection.GoReleaserConfig{ProjectName: "tool"}, "bin", "tool"},
+		{"binary", detection.GoReleaserConfig{}, "tool", "tool"},
+		{"nothing", detection.GoReleaserConfig{}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scoopManifestName(&tt.config, tt.binary); got != tt.want {
+				t.Errorf("scoopManifestName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"distui/internal/detection"
+	"distui/internal/forge"
+	"distui/internal/generator"
+	"distui/internal/github"
+	"distui/internal/models"
+)
+
+// ChecksumsFile is the checksum name_template of the generated config
+const ChecksumsFile = "checksums.txt"
+
+// uploadedArtifactTypes are the artifacts.json types GoReleaser attaches to a release
+var uploadedArtifactTypes = map[string]bool{
+	"Archive":       true,
+	"Linux Package": true,
+	"Checksum":      true,
+	"Signature":     true,
+	"Certificate":   true,
+	"SBOM":          true,
+	"Source":        true,
+	"File":          true,
+}
+
+// Verify checks every channel the release went to, after the fact
+func (r *ReleaseExecutor) Verify() tea.Cmd {
+	return func() tea.Msg {
+		return models.ReleaseVerifiedMsg{Version: r.config.Version, Checks: r.VerifyChannels()}
+	}
+}
+
+// VerifyChannels returns one check per channel: the release and its assets, the images,
+// the tap, Scoop, AUR, Winget and NUR, each npm registry and the module proxy
+func (r *ReleaseExecutor) VerifyChannels() []models.ChannelCheck {
+	checks := []models.ChannelCheck{r.verifyRelease()}
+	if r.config.Docker != nil {
+		checks = append(checks, r.verifyContainer())
+	}
+	if r.config.EnableHomebrew {
+		checks = append(checks, r.verifyHomebrew())
+	}
+	if r.config.EnableScoop {
+		checks = append(checks, verifiedCheck("Scoop", func() (string, error) {
+			return VerifyScoopManifest(r.projectPath, r.config.Version, r.binaryName())
+		}))
+	}
+	if r.config.AUR != nil {
+		checks = append(checks, verifiedCheck("AUR", func() (string, error) {
+			return VerifyAURPackage(r.config.AUR, r.config.Version)
+		}))
+	}
+	if r.config.Winget != nil {
+		checks = append(checks, verifiedCheck("Winget", func() (string, error) {
+			return FindWingetPR(r.projectPath, r.config.Winget, r.config.Version, r.binaryName())
+		}))
+	}
+	if r.config.Nix != nil && r.config.Nix.Mode == "nur" {
+		checks = append(checks, verifiedCheck("NUR", func() (string, error) {
+			return VerifyNURPackage(r.config.Nix, r.config.Version)
+		}))
+	}
+	if r.config.EnableNPM {
+		checks = append(checks, r.verifyNPM()...)
+	}
+	if r.config.GoModule != nil {
+		checks = append(checks, r.verifyGoProxy())
+	}
+	return checks
+}
+
+func (r *ReleaseExecutor) verifyRelease() models.ChannelCheck {
+	f, remote, err := forge.Detect(r.projectPath)
+	if err != nil {
+		return models.ChannelCheck{Channel: "Release", Detail: err.Error()}
+	}
+	check := models.ChannelCheck{Channel: f.Kind().String() + " release"}
+
+	if f.Kind() != forge.GitHub {
+		exists, err := f.ReleaseExists(remote, r.config.Version)
+		switch {
+		case err != nil:
+			check.Detail = err.Error()
+		case !exists:
+			check.Detail = "no release for " + r.config.Version
+		default:
+			check.OK = true
+			check.Detail = "release found, assets are only counted on GitHub"
+		}
+		return check
+	}
+
+	client, err := github.New(remote.Host)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	release, err := client.Release(remote.Owner, remote.Name, r.config.Version)
+	if github.IsNotFound(err) {
+		check.Detail = "no release for " + r.config.Version
+		return check
+	}
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+
+	expected, known := ExpectedAssetCount(r.projectPath)
+	check.OK, check.Detail = checkAssets(release.Assets, expected, known)
+	return check
+}
+
+// checkAssets wants checksums.txt and, when dist/ says how many, every uploaded artifact
+func checkAssets(assets []github.Asset, expected int, known bool) (bool, string) {
+	hasChecksums := false
+	for _, asset := range assets {
+		if asset.Name == ChecksumsFile {
+			hasChecksums = true
+		}
+	}
+
+	count := fmt.Sprintf("%d assets", len(assets))
+	if known {
+		count = fmt.Sprintf("%d/%d assets", len(assets), expected)
+	}
+	switch {
+	case len(assets) == 0:
+		return false, "release has no assets"
+	case !hasChecksums:
+		return false, count + ", " + ChecksumsFile + " missing"
+	case known && len(assets) < expected:
+		return false, count + ", uploads missing"
+	}
+	return true, count + ", " + ChecksumsFile
+}
+
+// ExpectedAssetCount counts what GoReleaser uploads from dist/artifacts.json. Plain binary
+// archives aren't told apart from build outputs there, so the count is a minimum.
+func ExpectedAssetCount(projectPath string) (int, bool) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "dist", "artifacts.json"))
+	if err != nil {
+		return 0, false
+	}
+	var artifacts []struct {
+		Type string `json:"type"`
+	}
+	if json.Unmarshal(data, &artifacts) != nil {
+		return 0, false
+	}
+	count := 0
+	for _, artifact := range artifacts {
+		if uploadedArtifactTypes[artifact.Type] {
+			count++
+		}
+	}
+	return count, count > 0
+}
+
+// verifiedCheck turns a release-time verifier into a check, its result is the detail
+func verifiedCheck(channel string, verify func() (string, error)) models.ChannelCheck {
+	check := models.ChannelCheck{Channel: channel}
+	detail, err := verify()
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	check.OK, check.Detail = true, detail
+	return check
+}
+
+// verifyContainer asks the registry for the manifest of every tag the release pushed
+func (r *ReleaseExecutor) verifyContainer() models.ChannelCheck {
+	check := models.ChannelCheck{Channel: "Container"}
+	if _, err := exec.LookPath("docker"); err != nil {
+		check.Skipped, check.Detail = true, "docker not installed"
+		return check
+	}
+
+	tags := generator.DockerImageTags(r.config.Docker, r.config.Version)
+	for _, tag := range tags {
+		output, err := exec.Command("docker", "manifest", "inspect", tag).CombinedOutput()
+		if err != nil {
+			check.Detail = fmt.Sprintf("%s: %s", tag, strings.TrimSpace(string(output)))
+			return check
+		}
+	}
+	check.OK, check.Detail = true, strings.Join(tags, ", ")
+	return check
+}
+
+func (r *ReleaseExecutor) verifyHomebrew() models.ChannelCheck {
+	check := models.ChannelCheck{Channel: "Homebrew"}
+	name := generator.BrewName(r.config.Project)
+	if name == "" {
+		name = r.config.ProjectName
+	}
+	if r.config.HomebrewTap == "" || name == "" {
+		check.Skipped, check.Detail = true, "no tap configured"
+		return check
+	}
+	if _, err := exec.LookPath("brew"); err != nil {
+		check.Skipped, check.Detail = true, "brew not installed"
+		return check
+	}
+
+	tap := generator.TapName(r.config.HomebrewTap)
+	refreshTap(tap)
+	info, err := detection.VerifyHomebrewFormula(tap, name)
+	want := "v" + strings.TrimPrefix(r.config.Version, "v")
+	switch {
+	case err != nil:
+		check.Detail = err.Error()
+	case !info.Exists:
+		check.Detail = tap + "/" + name + " not found"
+	case info.Version != want:
+		check.Detail = fmt.Sprintf("%s/%s is at %s", tap, name, info.Version)
+	default:
+		check.OK = true
+		check.Detail = fmt.Sprintf("%s/%s at %s", tap, name, info.Version)
+	}
+	return check
+}
+
+// refreshTap pulls a tapped tap, brew info reads the local checkout
+func refreshTap(tap string) {
+	output, err := exec.Command("brew", "--repository", tap).Output()
+	if err != nil {
+		return
+	}
+	dir := strings.TrimSpace(string(output))
+	if _, err := os.Stat(dir); err == nil {
+		exec.Command("git", "-C", dir, "pull", "--ff-only", "--quiet").Run()
+	}
+}
+
+func (r *ReleaseExecutor) verifyNPM() []models.ChannelCheck {
+	packageName, err := npmPackageName(r.projectPath)
+	if err != nil {
+		return []models.ChannelCheck{{Channel: "NPM", Detail: err.Error()}}
+	}
+
+	var checks []models.ChannelCheck
+	for _, registry := range generator.NPMRegistries(r.config.NPM) {
+		publisher, cleanup, err := registryPublisher(r.projectPath, r.config.Version, packageName, registry, r.config.NPM)
+		if err != nil {
+			checks = append(checks, models.ChannelCheck{Channel: "NPM", Detail: err.Error()})
+			continue
+		}
+		channel := publisher.registryName()
+		if channel != "NPM" {
+			channel = "NPM " + strings.TrimPrefix(channel, "https://")
+		}
+		check := models.ChannelCheck{Channel: channel}
+		published, err := publisher.CheckIfPublished()
+		cleanup()
+		spec := packageName + "@" + strings.TrimPrefix(r.config.Version, "v")
+		switch {
+		case err != nil:
+			check.Detail = err.Error()
+		case !published:
+			check.Detail = spec + " not found"
+		default:
+			check.OK = true
+			check.Detail = spec
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func npmPackageName(projectPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "package.json"))
+	if err != nil {
+		return "", fmt.Errorf("reading package.json: %w", err)
+	}
+	var pkg struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return "", fmt.Errorf("parsing package.json: %w", err)
+	}
+	if pkg.Name == "" {
+		return "", fmt.Errorf("package.json has no name")
+	}
+	return pkg.Name, nil
+}
+
+func (r *ReleaseExecutor) verifyGoProxy() models.ChannelCheck {
+	check := models.ChannelCheck{Channel: "Go Module"}
+	modulePath := r.modulePath()
+	if modulePath == "" {
+		check.Skipped, check.Detail = true, "no module path in go.mod"
+		return check
+	}
+	proxy := GoProxyURL(r.config.GoModule)
+	url, err := GoProxyInfoURL(proxy, modulePath, r.config.Version)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	info, _, err := fetchGoProxyInfo(url)
+	switch {
+	case err != nil:
+		check.Detail = err.Error()
+	case info.Version != r.config.Version:
+		check.Detail = fmt.Sprintf("proxy answered with %s", info.Version)
+	default:
+		check.OK = true
+		check.Detail = fmt.Sprintf("%s@%s on %s", modulePath, info.Version, proxy)
+	}
+	return check
+}
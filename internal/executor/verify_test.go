@@ -0,0 +1,81 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"distui/internal/github"
+)
+
+func TestCheckAssets(t *testing.T) {
+	assets := func(names ...string) []github.Asset {
+		var list []github.Asset
+		for _, name := range names {
+			list = append(list, github.Asset{Name: name})
+		}
+		return list
+	}
+
+	tests := []struct {
+		name     string
+		assets   []github.Asset
+		expected int
+		known    bool
+		wantOK   bool
+		detail   string
+	}{
+		{"all uploaded", assets("tool_linux_amd64.tar.gz", "tool_darwin_arm64.tar.gz", "checksums.txt"), 3, true, true, "3/3 assets"},
+		{"count unknown", assets("tool_linux_amd64.tar.gz", "checksums.txt"), 0, false, true, "2 assets"},
+		{"more than counted", assets("a.tar.gz", "b.tar.gz", "checksums.txt"), 2, true, true, "3/2 assets"},
+		{"uploads missing", assets("tool_linux_amd64.tar.gz", "checksums.txt"), 3, true, false, "uploads missing"},
+		{"no checksums", assets("tool_linux_amd64.tar.gz"), 1, true, false, "checksums.txt missing"},
+		{"empty release", nil, 3, true, false, "no assets"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, detail := checkAssets(tt.assets, tt.expected, tt.known)
+			if ok != tt.wantOK || !strings.Contains(detail, tt.detail) {
+				t.Errorf("checkAssets() = %v, %q, want %v, %q", ok, detail, tt.wantOK, tt.detail)
+			}
+		})
+	}
+}
+
+func TestExpectedAssetCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		artifacts string
+		want      int
+		wantKnown bool
+	}{
+		{"uploaded types only", `[
+			{"name":"tool","type":"Binary"},
+			{"name":"tool_linux_amd64.tar.gz","type":"Archive"},
+			{"name":"tool_1.0.0_amd64.deb","type":"Linux Package"},
+			{"name":"checksums.txt","type":"Checksum"},
+			{"name":"tool.rb","type":"Brew Tap"}
+		]`, 3, true},
+		{"nothing uploaded", `[{"name":"tool","type":"Binary"}]`, 0, false},
+		{"not json", `{`, 0, false},
+		{"no dist", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.artifacts != "" {
+				if err := os.MkdirAll(filepath.Join(dir, "dist"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, "dist", "artifacts.json"), []byte(tt.artifacts), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, known := ExpectedAssetCount(dir)
+			if got != tt.want || known != tt.wantKnown {
+				t.Errorf("ExpectedAssetCount() = %d, %v, want %d, %v", got, known, tt.want, tt.wantKnown)
+			}
+		})
+	}
+}
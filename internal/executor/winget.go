@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/internal/detection"
+	"distui/internal/generator"
+	"distui/internal/github"
+	"distui/internal/models"
+)
+
+// ValidateWinget checks the winget metadata and that the fork the manifest is pushed to exists
+func ValidateWinget(config *models.WingetConfig) error {
+	if err := generator.ValidateWingetConfig(config); err != nil {
+		return err
+	}
+
+	client, err := github.New(github.DefaultHost)
+	if err != nil {
+		return fmt.Errorf("winget needs a github.com token: %w", err)
+	}
+	owner, name, _ := strings.Cut(config.ForkRepo, "/")
+	if _, err := client.Repo(owner, name); err != nil {
+		if github.IsNotFound(err) {
+			return fmt.Errorf("winget fork %s not found, fork %s on GitHub first", config.ForkRepo, generator.WingetBaseRepo)
+		}
+		return fmt.Errorf("checking winget fork %s: %w", config.ForkRepo, err)
+	}
+	return nil
+}
+
+// FindWingetPR returns the url of the manifest pull request GoReleaser opened for version.
+// It looks for the fork's release branch, the PR author is whoever owns the token, which
+// isn't the fork owner when the fork belongs to an organization.
+func FindWingetPR(projectPath string, config *models.WingetConfig, version, binary string) (string, error) {
+	grConfig, err := detection.DetectGoReleaserConfig(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("reading goreleaser config: %w", err)
+	}
+	projectName := grConfig.ProjectName
+	if projectName == "" {
+		projectName = binary
+	}
+	forkOwner, _, _ := strings.Cut(config.ForkRepo, "/")
+	head := forkOwner + ":" + WingetBranch(projectName, version)
+
+	client, err := github.New(github.DefaultHost)
+	if err != nil {
+		return "", fmt.Errorf("winget needs a github.com token: %w", err)
+	}
+	baseOwner, baseName, _ := strings.Cut(generator.WingetBaseRepo, "/")
+	prs, err := client.PullRequests(baseOwner, baseName, "all", head, "")
+	if err != nil {
+		return "", fmt.Errorf("listing winget pull requests: %w", err)
+	}
+	if len(prs) == 0 {
+		return "", fmt.Errorf("no pull request from %s on %s", head, generator.WingetBaseRepo)
+	}
+	return prs[0].HTMLURL, nil
+}
+
+// WingetBranch is the fork branch of the generated winget section, "{{ .ProjectName }}-{{ .Version }}"
+func WingetBranch(projectName, version string) string {
+	return projectName + "-" + strings.TrimPrefix(version, "v")
+}
@@ -0,0 +1,130 @@
+package forge
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"distui/internal/models"
+)
+
+var (
+	accountsMu  sync.RWMutex
+	accounts    = map[string]models.ForgeAccount{}
+	githubHosts = map[string]bool{}
+)
+
+// SetAccounts registers the forge accounts from the global config. Their hosts are
+// recognised in remotes and their API URLs override the defaults.
+func SetAccounts(list []models.ForgeAccount) {
+	accountsMu.Lock()
+	defer accountsMu.Unlock()
+	accounts = map[string]models.ForgeAccount{}
+	for _, account := range list {
+		if account.Host != "" {
+			accounts[strings.ToLower(account.Host)] = account
+		}
+	}
+}
+
+// SetGitHubAccounts registers the GitHub Enterprise Server hosts of the configured accounts
+func SetGitHubAccounts(list []models.GitHubAccount) {
+	accountsMu.Lock()
+	defer accountsMu.Unlock()
+	githubHosts = map[string]bool{}
+	for _, account := range list {
+		if account.Host != "" {
+			githubHosts[strings.ToLower(account.Host)] = true
+		}
+	}
+}
+
+func isGitHubHost(host string) bool {
+	accountsMu.RLock()
+	defer accountsMu.RUnlock()
+	return githubHosts[host]
+}
+
+// AccountFor returns the configured account on host
+func AccountFor(host string) (models.ForgeAccount, bool) {
+	accountsMu.RLock()
+	defer accountsMu.RUnlock()
+	account, ok := accounts[strings.ToLower(host)]
+	return account, ok
+}
+
+// APIURL is the REST API base of host, from its account or the forge's default path
+func APIURL(kind Kind, host string) string {
+	if account, ok := AccountFor(host); ok && account.APIURL != "" {
+		return strings.TrimSuffix(account.APIURL, "/")
+	}
+	switch kind {
+	case GitLab:
+		return GitLabAPIURL(host)
+	case Gitea, Forgejo:
+		return GiteaAPIURL(host)
+	}
+	return GitHubAPIURL(host)
+}
+
+// ParseKind accepts the kinds used in config files
+func ParseKind(kind string) (Kind, bool) {
+	switch Kind(strings.ToLower(kind)) {
+	case GitHub:
+		return GitHub, true
+	case GitLab:
+		return GitLab, true
+	case Gitea:
+		return Gitea, true
+	case Forgejo:
+		return Forgejo, true
+	}
+	return "", false
+}
+
+// ParseAccounts reads accounts written as kind:user@host, with =api-url to override the
+// API base, e.g. forgejo:alice@git.example.com=https://git.example.com/api/v1
+func ParseAccounts(value string) ([]models.ForgeAccount, error) {
+	var list []models.ForgeAccount
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kindName, rest, ok := strings.Cut(entry, ":")
+		kind, known := ParseKind(kindName)
+		if !ok || !known || kind == GitHub {
+			return nil, fmt.Errorf("%q: start with gitlab:, gitea: or forgejo:", entry)
+		}
+		rest, apiURL, _ := strings.Cut(rest, "=")
+		username, host, found := strings.Cut(rest, "@")
+		if !found {
+			host, username = username, ""
+		}
+		if host == "" || strings.ContainsAny(host, "/ ") {
+			return nil, fmt.Errorf("%q: expected %s:user@host", entry, kind)
+		}
+		if apiURL != "" && !strings.HasPrefix(apiURL, "https://") && !strings.HasPrefix(apiURL, "http://") {
+			return nil, fmt.Errorf("%q: the API URL must be http(s)", entry)
+		}
+		list = append(list, models.ForgeAccount{Kind: string(kind), Host: strings.ToLower(host), APIURL: apiURL, Username: username})
+	}
+	return list, nil
+}
+
+// FormatAccounts is the inverse of ParseAccounts
+func FormatAccounts(list []models.ForgeAccount) string {
+	var entries []string
+	for _, account := range list {
+		entry := account.Kind + ":"
+		if account.Username != "" {
+			entry += account.Username + "@"
+		}
+		entry += account.Host
+		if account.APIURL != "" {
+			entry += "=" + account.APIURL
+		}
+		entries = append(entries, entry)
+	}
+	return strings.Join(entries, ", ")
+}
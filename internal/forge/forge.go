@@ -0,0 +1,66 @@
+// Package forge hides the differences between the places a project can be hosted:
+// tokens, repository creation, releases and pull/merge requests.
+package forge
+
+import "fmt"
+
+// Forge is a GitHub, GitLab, Gitea or Forgejo instance
+type Forge interface {
+	Kind() Kind
+	Host() string
+	// TokenEnv is the variable GoReleaser reads the token from
+	TokenEnv() string
+	Token() (string, error)
+	RepoExists(repo Remote) (bool, error)
+	CreateRepo(opts RepoOptions) (Remote, error)
+	ReleaseExists(repo Remote, tag string) (bool, error)
+	// CreatePullRequest opens a pull (merge) request and returns its URL. An already open
+	// request for the same branches is not an error.
+	CreatePullRequest(repo Remote, pr PullRequest) (string, error)
+}
+
+// RepoOptions describes a repository to create. An empty Owner means the authenticated user.
+type RepoOptions struct {
+	Owner       string
+	Name        string
+	Description string
+	Private     bool
+}
+
+// PullRequest asks to merge Head into Base
+type PullRequest struct {
+	Head  string
+	Base  string
+	Title string
+	Body  string
+}
+
+// For returns the forge hosting remote
+func For(remote Remote) (Forge, error) {
+	switch remote.Kind {
+	case GitHub:
+		return NewGitHub(remote.Host), nil
+	case GitLab:
+		return NewGitLab(remote.Host), nil
+	case Gitea, Forgejo:
+		return NewGitea(remote.Kind, remote.Host), nil
+	}
+	return nil, fmt.Errorf("unsupported forge %q", remote.Kind)
+}
+
+// Detect returns the forge and repository of the origin remote in dir
+func Detect(dir string) (Forge, Remote, error) {
+	origin, err := Origin(dir)
+	if err != nil {
+		return nil, Remote{}, err
+	}
+	remote, err := ParseRemote(origin)
+	if err != nil {
+		return nil, Remote{}, err
+	}
+	f, err := For(remote)
+	if err != nil {
+		return nil, Remote{}, err
+	}
+	return f, remote, nil
+}
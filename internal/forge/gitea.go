@@ -0,0 +1,183 @@
+package forge
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GiteaForge talks to the Gitea REST API, which Forgejo shares
+type GiteaForge struct {
+	kind  Kind
+	api   *apiClient
+	token string
+}
+
+func NewGitea(kind Kind, host string) *GiteaForge {
+	g := &GiteaForge{kind: kind}
+	g.api = &apiClient{
+		host:      host,
+		baseURL:   APIURL(kind, host),
+		client:    &http.Client{Timeout: 30 * time.Second},
+		authorize: g.authorize,
+	}
+	return g
+}
+
+// GiteaAPIURL is the v1 API of a Gitea or Forgejo host
+func GiteaAPIURL(host string) string {
+	return "https://" + host + "/api/v1"
+}
+
+func (g *GiteaForge) Kind() Kind       { return g.kind }
+func (g *GiteaForge) Host() string     { return g.api.host }
+func (g *GiteaForge) TokenEnv() string { return "GITEA_TOKEN" }
+
+// Token comes from GITEA_TOKEN, or from the tea CLI's login for the host
+func (g *GiteaForge) Token() (string, error) {
+	if g.token != "" {
+		return g.token, nil
+	}
+	if token := strings.TrimSpace(os.Getenv("GITEA_TOKEN")); token != "" {
+		g.token = token
+		return token, nil
+	}
+	if token := teaToken(g.Host()); token != "" {
+		g.token = token
+		return token, nil
+	}
+	return "", fmt.Errorf("no %s token for %s: set GITEA_TOKEN or run tea login add", g.kind, g.Host())
+}
+
+// teaToken reads the token of the tea login for host from ~/.config/tea/config.yml
+func teaToken(host string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".config", "tea", "config.yml"))
+	if err != nil {
+		return ""
+	}
+	var config struct {
+		Logins []struct {
+			URL   string `yaml:"url"`
+			Token string `yaml:"token"`
+		} `yaml:"logins"`
+	}
+	if yaml.Unmarshal(data, &config) != nil {
+		return ""
+	}
+	for _, login := range config.Logins {
+		if u, err := url.Parse(login.URL); err == nil && strings.EqualFold(u.Host, host) {
+			return login.Token
+		}
+	}
+	return ""
+}
+
+func (g *GiteaForge) authorize(req *http.Request) error {
+	token, err := g.Token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "token "+token)
+	return nil
+}
+
+func (g *GiteaForge) RepoExists(repo Remote) (bool, error) {
+	err := g.api.do("GET", "/repos/"+repoPath(repo), nil, nil)
+	if isNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CreateRepo creates the repo for the user, or in the org when Owner is someone else
+func (g *GiteaForge) CreateRepo(opts RepoOptions) (Remote, error) {
+	path := "/user/repos"
+	if opts.Owner != "" {
+		var user struct {
+			Login string `json:"login"`
+		}
+		if err := g.api.do("GET", "/user", nil, &user); err != nil {
+			return Remote{}, fmt.Errorf("getting %s user: %w", g.kind, err)
+		}
+		if !strings.EqualFold(user.Login, opts.Owner) {
+			path = "/orgs/" + url.PathEscape(opts.Owner) + "/repos"
+		}
+	}
+
+	body := map[string]interface{}{
+		"name":        opts.Name,
+		"description": opts.Description,
+		"private":     opts.Private,
+	}
+	var created struct {
+		Name  string `json:"name"`
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	}
+	if err := g.api.do("POST", path, body, &created); err != nil {
+		return Remote{}, fmt.Errorf("failed to create %s repo: %w", g.kind, err)
+	}
+	return Remote{Kind: g.kind, Host: g.Host(), Owner: created.Owner.Login, Name: created.Name}, nil
+}
+
+func (g *GiteaForge) ReleaseExists(repo Remote, tag string) (bool, error) {
+	err := g.api.do("GET", "/repos/"+repoPath(repo)+"/releases/tags/"+url.PathEscape(tag), nil, nil)
+	if isNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CreatePullRequest opens a pull request. Gitea answers 409 when one is already open
+// for the branches, its URL is returned then.
+func (g *GiteaForge) CreatePullRequest(repo Remote, pr PullRequest) (string, error) {
+	body := map[string]interface{}{
+		"head":  pr.Head,
+		"base":  pr.Base,
+		"title": pr.Title,
+		"body":  pr.Body,
+	}
+	var created giteaPull
+	err := g.api.do("POST", "/repos/"+repoPath(repo)+"/pulls", body, &created)
+	if isStatus(err, http.StatusConflict) {
+		var open []giteaPull
+		if err := g.api.do("GET", "/repos/"+repoPath(repo)+"/pulls?state=open", nil, &open); err != nil {
+			return "", fmt.Errorf("finding open pull request: %w", err)
+		}
+		for _, pull := range open {
+			if pull.Head.Ref == pr.Head && pull.Base.Ref == pr.Base {
+				return pull.HTMLURL, nil
+			}
+		}
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("creating PR to %s: %w", pr.Base, err)
+	}
+	return created.HTMLURL, nil
+}
+
+type giteaPull struct {
+	HTMLURL string `json:"html_url"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+func repoPath(repo Remote) string {
+	return url.PathEscape(repo.Owner) + "/" + url.PathEscape(repo.Name)
+}
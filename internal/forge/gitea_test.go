@@ -0,0 +1,152 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func newTestGitea(t *testing.T, handler http.HandlerFunc) *GiteaForge {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token gitea-test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"token is required"}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	gitea := NewGitea(Forgejo, "git.acme.io")
+	gitea.api.baseURL = server.URL
+	gitea.api.client = server.Client()
+	gitea.token = "gitea-test"
+	return gitea
+}
+
+func TestGiteaAccounts(t *testing.T) {
+	SetAccounts([]models.ForgeAccount{{Kind: "forgejo", Host: "git.acme.io", APIURL: "https://git.acme.io/forgejo/api/v1/"}})
+	defer SetAccounts(nil)
+
+	remote, err := ParseRemote("ssh://git@git.acme.io:2222/infra/tool.git")
+	if err != nil {
+		t.Fatalf("ParseRemote failed: %v", err)
+	}
+	if remote != (Remote{Forgejo, "git.acme.io", "infra", "tool"}) {
+		t.Errorf("remote = %+v", remote)
+	}
+	if got := APIURL(remote.Kind, remote.Host); got != "https://git.acme.io/forgejo/api/v1" {
+		t.Errorf("api url = %s", got)
+	}
+	if got := APIURL(Gitea, "gitea.example.com"); got != "https://gitea.example.com/api/v1" {
+		t.Errorf("default api url = %s", got)
+	}
+	if _, err := ParseRemote("git@gitea.example.com:infra/tool.git"); err == nil {
+		t.Error("unconfigured Gitea hosts can't be recognised")
+	}
+}
+
+func TestParseAccounts(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"forgejo:alice@Git.Acme.io", "forgejo:alice@git.acme.io", false},
+		{" gitea:git.acme.io=https://git.acme.io/gitea/api/v1 , gitlab:bob@code.corp", "gitea:git.acme.io=https://git.acme.io/gitea/api/v1, gitlab:bob@code.corp", false},
+		{"github:alice@github.com", "", true},
+		{"alice@git.acme.io", "", true},
+		{"forgejo:alice@", "", true},
+		{"forgejo:alice@git.acme.io=git.acme.io/api", "", true},
+	}
+	for _, tt := range tests {
+		list, err := ParseAccounts(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseAccounts(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got := FormatAccounts(list); got != tt.want {
+			t.Errorf("ParseAccounts(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestGiteaCreateRepo(t *testing.T) {
+	var path string
+	var created map[string]interface{}
+	gitea := newTestGitea(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user":
+			fmt.Fprint(w, `{"login": "alice"}`)
+		case "POST /user/repos", "POST /orgs/infra/repos":
+			path = r.URL.Path
+			json.NewDecoder(r.Body).Decode(&created)
+			owner := "alice"
+			if r.URL.Path == "/orgs/infra/repos" {
+				owner = "infra"
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"name": %q, "owner": {"login": %q}}`, created["name"], owner)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	tests := []struct {
+		owner    string
+		wantPath string
+	}{
+		{"", "/user/repos"},
+		{"alice", "/user/repos"},
+		{"infra", "/orgs/infra/repos"},
+	}
+	for _, tt := range tests {
+		t.Run("owner "+tt.owner, func(t *testing.T) {
+			repo, err := gitea.CreateRepo(RepoOptions{Owner: tt.owner, Name: "tool", Private: true})
+			if err != nil {
+				t.Fatalf("CreateRepo failed: %v", err)
+			}
+			if path != tt.wantPath || created["private"] != true {
+				t.Errorf("request = %s %v", path, created)
+			}
+			if repo.Kind != Forgejo || repo.Name != "tool" || (tt.owner != "" && repo.Owner != tt.owner) {
+				t.Errorf("repo = %+v", repo)
+			}
+		})
+	}
+}
+
+func TestGiteaReleaseAndPullRequest(t *testing.T) {
+	gitea := newTestGitea(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/infra/tool/releases/tags/v1.0.0":
+			fmt.Fprint(w, `{"tag_name": "v1.0.0"}`)
+		case "POST /repos/infra/tool/pulls":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message":"pull request already exists for these targets"}`)
+		case "GET /repos/infra/tool/pulls":
+			fmt.Fprint(w, `[{"html_url": "https://git.acme.io/infra/tool/pulls/1", "head": {"ref": "other"}, "base": {"ref": "main"}},
+				{"html_url": "https://git.acme.io/infra/tool/pulls/2", "head": {"ref": "feature"}, "base": {"ref": "main"}}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"not found"}`)
+		}
+	})
+	repo := Remote{Forgejo, "git.acme.io", "infra", "tool"}
+
+	if exists, err := gitea.ReleaseExists(repo, "v1.0.0"); err != nil || !exists {
+		t.Errorf("ReleaseExists(v1.0.0) = %v, %v", exists, err)
+	}
+	if exists, err := gitea.ReleaseExists(repo, "v2.0.0"); err != nil || exists {
+		t.Errorf("ReleaseExists(v2.0.0) = %v, %v", exists, err)
+	}
+	url, err := gitea.CreatePullRequest(repo, PullRequest{Head: "feature", Base: "main", Title: "Add feature"})
+	if err != nil || url != "https://git.acme.io/infra/tool/pulls/2" {
+		t.Errorf("CreatePullRequest = %s, %v", url, err)
+	}
+}
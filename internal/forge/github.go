@@ -0,0 +1,117 @@
+package forge
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/internal/github"
+)
+
+// GitHubForge talks to the GitHub REST API, github.com or Enterprise Server
+type GitHubForge struct {
+	host   string
+	client *github.Client
+}
+
+func NewGitHub(host string) *GitHubForge {
+	if host == "" {
+		host = DefaultGitHubHost
+	}
+	return &GitHubForge{host: host}
+}
+
+// GitHubAPIURL is the REST API of github.com or of a GitHub Enterprise Server host
+func GitHubAPIURL(host string) string {
+	return github.APIURL(host)
+}
+
+func (g *GitHubForge) Kind() Kind       { return GitHub }
+func (g *GitHubForge) Host() string     { return g.host }
+func (g *GitHubForge) TokenEnv() string { return "GITHUB_TOKEN" }
+
+// Token is the environment's or gh's token for the host, see github.Token
+func (g *GitHubForge) Token() (string, error) {
+	return github.Token(g.host)
+}
+
+// api creates the client on first use, so a forge that is never called needs no token
+func (g *GitHubForge) api() (*github.Client, error) {
+	if g.client == nil {
+		client, err := github.New(g.host)
+		if err != nil {
+			return nil, err
+		}
+		g.client = client
+	}
+	return g.client, nil
+}
+
+func (g *GitHubForge) RepoExists(repo Remote) (bool, error) {
+	api, err := g.api()
+	if err != nil {
+		return false, err
+	}
+	_, err = api.Repo(repo.Owner, repo.Name)
+	if github.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("checking %s: %w", repo.FullName(), err)
+	}
+	return true, nil
+}
+
+// CreateRepo creates the repo for the user, or in the org when Owner is someone else
+func (g *GitHubForge) CreateRepo(opts RepoOptions) (Remote, error) {
+	api, err := g.api()
+	if err != nil {
+		return Remote{}, err
+	}
+	newRepo := github.NewRepository{Name: opts.Name, Description: opts.Description, Private: opts.Private}
+	if opts.Owner != "" {
+		user, err := api.User()
+		if err != nil {
+			return Remote{}, err
+		}
+		if !strings.EqualFold(user.Login, opts.Owner) {
+			newRepo.Org = opts.Owner
+		}
+	}
+	created, err := api.CreateRepo(newRepo)
+	if err != nil {
+		return Remote{}, err
+	}
+	return Remote{Kind: GitHub, Host: g.host, Owner: created.Owner.Login, Name: created.Name}, nil
+}
+
+func (g *GitHubForge) ReleaseExists(repo Remote, tag string) (bool, error) {
+	api, err := g.api()
+	if err != nil {
+		return false, err
+	}
+	_, err = api.Release(repo.Owner, repo.Name, tag)
+	if github.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("checking release %s: %w", tag, err)
+	}
+	return true, nil
+}
+
+func (g *GitHubForge) CreatePullRequest(repo Remote, pr PullRequest) (string, error) {
+	api, err := g.api()
+	if err != nil {
+		return "", err
+	}
+	created, err := api.CreatePullRequest(repo.Owner, repo.Name, github.NewPullRequest{
+		Title: pr.Title,
+		Head:  pr.Head,
+		Base:  pr.Base,
+		Body:  pr.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
@@ -0,0 +1,154 @@
+package forge
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// GitLabForge talks to the GitLab REST API, gitlab.com or self-managed
+type GitLabForge struct {
+	api   *apiClient
+	token string
+}
+
+func NewGitLab(host string) *GitLabForge {
+	if host == "" {
+		host = DefaultGitLabHost
+	}
+	g := &GitLabForge{}
+	g.api = &apiClient{
+		host:      host,
+		baseURL:   APIURL(GitLab, host),
+		client:    &http.Client{Timeout: 30 * time.Second},
+		authorize: g.authorize,
+	}
+	return g
+}
+
+// GitLabAPIURL is the v4 API of a GitLab host
+func GitLabAPIURL(host string) string {
+	return "https://" + host + "/api/v4"
+}
+
+func (g *GitLabForge) Kind() Kind       { return GitLab }
+func (g *GitLabForge) Host() string     { return g.api.host }
+func (g *GitLabForge) TokenEnv() string { return "GITLAB_TOKEN" }
+
+// Token comes from GITLAB_TOKEN, or from glab's login for the host
+func (g *GitLabForge) Token() (string, error) {
+	if g.token != "" {
+		return g.token, nil
+	}
+	if token := strings.TrimSpace(os.Getenv("GITLAB_TOKEN")); token != "" {
+		g.token = token
+		return token, nil
+	}
+	host := g.Host()
+	output, err := exec.Command("glab", "config", "get", "token", "--host", host).Output()
+	if token := strings.TrimSpace(string(output)); err == nil && token != "" {
+		g.token = token
+		return token, nil
+	}
+	return "", fmt.Errorf("no GitLab token for %s: set GITLAB_TOKEN or run glab auth login --hostname %s", host, host)
+}
+
+func (g *GitLabForge) authorize(req *http.Request) error {
+	token, err := g.Token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("PRIVATE-TOKEN", token)
+	return nil
+}
+
+func (g *GitLabForge) RepoExists(repo Remote) (bool, error) {
+	err := g.api.do("GET", "/projects/"+projectID(repo), nil, nil)
+	if isNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (g *GitLabForge) CreateRepo(opts RepoOptions) (Remote, error) {
+	body := map[string]interface{}{
+		"name":        opts.Name,
+		"path":        opts.Name,
+		"description": opts.Description,
+		"visibility":  "public",
+	}
+	if opts.Private {
+		body["visibility"] = "private"
+	}
+	if opts.Owner != "" {
+		var namespace struct {
+			ID int `json:"id"`
+		}
+		if err := g.api.do("GET", "/namespaces/"+url.PathEscape(opts.Owner), nil, &namespace); err != nil {
+			return Remote{}, fmt.Errorf("looking up GitLab namespace %s: %w", opts.Owner, err)
+		}
+		body["namespace_id"] = namespace.ID
+	}
+
+	var project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	}
+	if err := g.api.do("POST", "/projects", body, &project); err != nil {
+		return Remote{}, fmt.Errorf("failed to create GitLab project: %w", err)
+	}
+
+	i := strings.LastIndex(project.PathWithNamespace, "/")
+	if i < 0 {
+		return Remote{}, fmt.Errorf("unexpected GitLab project path %q", project.PathWithNamespace)
+	}
+	return Remote{Kind: GitLab, Host: g.Host(), Owner: project.PathWithNamespace[:i], Name: project.PathWithNamespace[i+1:]}, nil
+}
+
+func (g *GitLabForge) ReleaseExists(repo Remote, tag string) (bool, error) {
+	err := g.api.do("GET", "/projects/"+projectID(repo)+"/releases/"+url.PathEscape(tag), nil, nil)
+	if isNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CreatePullRequest opens a merge request. GitLab answers 409 when one is already open
+// for the branches, its URL is returned then.
+func (g *GitLabForge) CreatePullRequest(repo Remote, pr PullRequest) (string, error) {
+	body := map[string]interface{}{
+		"source_branch": pr.Head,
+		"target_branch": pr.Base,
+		"title":         pr.Title,
+		"description":   pr.Body,
+	}
+	var mr struct {
+		WebURL string `json:"web_url"`
+	}
+	err := g.api.do("POST", "/projects/"+projectID(repo)+"/merge_requests", body, &mr)
+	if isStatus(err, http.StatusConflict) {
+		query := url.Values{"state": {"opened"}, "source_branch": {pr.Head}, "target_branch": {pr.Base}}
+		var open []struct {
+			WebURL string `json:"web_url"`
+		}
+		if err := g.api.do("GET", "/projects/"+projectID(repo)+"/merge_requests?"+query.Encode(), nil, &open); err != nil {
+			return "", fmt.Errorf("finding open merge request: %w", err)
+		}
+		if len(open) > 0 {
+			return open[0].WebURL, nil
+		}
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("creating merge request to %s: %w", pr.Base, err)
+	}
+	return mr.WebURL, nil
+}
+
+// projectID is the URL-encoded path GitLab accepts in place of the numeric id
+func projectID(repo Remote) string {
+	return url.PathEscape(repo.FullName())
+}
@@ -0,0 +1,133 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestGitLab(t *testing.T, handler http.HandlerFunc) *GitLabForge {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "glpat-test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"401 Unauthorized"}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	gitlab := NewGitLab("gitlab.acme.io")
+	gitlab.api.baseURL = server.URL
+	gitlab.api.client = server.Client()
+	gitlab.token = "glpat-test"
+	return gitlab
+}
+
+func TestGitLabCreateRepo(t *testing.T) {
+	var created map[string]interface{}
+	gitlab := newTestGitLab(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /namespaces/platform%2Ftools":
+			fmt.Fprint(w, `{"id": 42, "full_path": "platform/tools"}`)
+		case "POST /projects":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 7, "path_with_namespace": "platform/tools/cli"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	repo, err := gitlab.CreateRepo(RepoOptions{Owner: "platform/tools", Name: "cli", Private: true})
+	if err != nil {
+		t.Fatalf("CreateRepo failed: %v", err)
+	}
+	if want := (Remote{GitLab, "gitlab.acme.io", "platform/tools", "cli"}); repo != want {
+		t.Errorf("repo = %+v, want %+v", repo, want)
+	}
+	if created["namespace_id"] != float64(42) || created["visibility"] != "private" {
+		t.Errorf("create request = %v", created)
+	}
+}
+
+func TestGitLabReleaseExists(t *testing.T) {
+	gitlab := newTestGitLab(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/projects/team%2Ftool/releases/v1.0.0":
+			fmt.Fprint(w, `{"tag_name": "v1.0.0"}`)
+		case "/projects/team%2Ftool/releases/v2.0.0":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"404 Not Found"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":"boom"}`)
+		}
+	})
+	repo := Remote{GitLab, "gitlab.acme.io", "team", "tool"}
+
+	tests := []struct {
+		tag     string
+		want    bool
+		wantErr bool
+	}{
+		{"v1.0.0", true, false},
+		{"v2.0.0", false, false},
+		{"v3.0.0", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := gitlab.ReleaseExists(repo, tt.tag)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ReleaseExists(%s) = %v, %v", tt.tag, got, err)
+			}
+		})
+	}
+}
+
+func TestGitLabCreatePullRequest(t *testing.T) {
+	existing := false
+	gitlab := newTestGitLab(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "POST /projects/team%2Ftool/merge_requests":
+			if existing {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"message":["Another open merge request already exists for this source branch: !3"]}`)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"iid": 4, "web_url": "https://gitlab.acme.io/team/tool/-/merge_requests/4"}`)
+		case "GET /projects/team%2Ftool/merge_requests":
+			if r.URL.Query().Get("source_branch") != "feature" {
+				t.Errorf("query = %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `[{"iid": 3, "web_url": "https://gitlab.acme.io/team/tool/-/merge_requests/3"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	repo := Remote{GitLab, "gitlab.acme.io", "team", "tool"}
+	pr := PullRequest{Head: "feature", Base: "main", Title: "Add feature"}
+
+	url, err := gitlab.CreatePullRequest(repo, pr)
+	if err != nil || url != "https://gitlab.acme.io/team/tool/-/merge_requests/4" {
+		t.Errorf("new merge request = %s, %v", url, err)
+	}
+	existing = true
+	url, err = gitlab.CreatePullRequest(repo, pr)
+	if err != nil || url != "https://gitlab.acme.io/team/tool/-/merge_requests/3" {
+		t.Errorf("existing merge request = %s, %v", url, err)
+	}
+}
+
+func TestGitLabUnauthorized(t *testing.T) {
+	gitlab := newTestGitLab(t, func(w http.ResponseWriter, r *http.Request) {})
+	gitlab.token = "wrong"
+	_, err := gitlab.RepoExists(Remote{GitLab, "gitlab.acme.io", "team", "tool"})
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Status != http.StatusUnauthorized {
+		t.Errorf("err = %v, want a 401 APIError", err)
+	}
+}
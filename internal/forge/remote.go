@@ -0,0 +1,173 @@
+package forge
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Kind is the software hosting a repository
+type Kind string
+
+const (
+	GitHub  Kind = "github"
+	GitLab  Kind = "gitlab"
+	Gitea   Kind = "gitea"
+	Forgejo Kind = "forgejo" // Gitea's API, told apart for the Actions workflow
+)
+
+const (
+	DefaultGitHubHost = "github.com"
+	DefaultGitLabHost = "gitlab.com"
+)
+
+func (k Kind) String() string {
+	switch k {
+	case GitHub:
+		return "GitHub"
+	case GitLab:
+		return "GitLab"
+	case Gitea:
+		return "Gitea"
+	case Forgejo:
+		return "Forgejo"
+	}
+	return string(k)
+}
+
+// Remote is a repository on a forge. GitLab owners can be nested groups, group/subgroup.
+type Remote struct {
+	Kind  Kind
+	Host  string
+	Owner string
+	Name  string
+}
+
+// FullName is owner/name, the path of the repository on its host
+func (r Remote) FullName() string {
+	return r.Owner + "/" + r.Name
+}
+
+// WebURL is the repository's page
+func (r Remote) WebURL() string {
+	return "https://" + r.Host + "/" + r.FullName()
+}
+
+// SSHURL is the remote git pushes to
+func (r Remote) SSHURL() string {
+	return "git@" + r.Host + ":" + r.FullName() + ".git"
+}
+
+// ReleaseURL is the page of the release for tag
+func (r Remote) ReleaseURL(tag string) string {
+	if r.Kind == GitLab {
+		return r.WebURL() + "/-/releases/" + tag
+	}
+	return r.WebURL() + "/releases/tag/" + tag
+}
+
+// IsGitea reports whether the kind speaks Gitea's API, Forgejo included
+func (k Kind) IsGitea() bool {
+	return k == Gitea || k == Forgejo
+}
+
+// KindForHost tells forges apart by host. Configured accounts come first. Self-managed
+// GitLab is also recognised by a gitlab.* hostname or by GITLAB_HOST, the variable glab
+// uses for the same purpose, GitHub Enterprise Server likewise by GH_HOST. Gitea and
+// Forgejo hosts have to be configured.
+func KindForHost(host string) (Kind, bool) {
+	host = strings.ToLower(host)
+	if account, ok := AccountFor(host); ok {
+		if kind, ok := ParseKind(account.Kind); ok {
+			return kind, true
+		}
+	}
+	switch {
+	case host == DefaultGitHubHost, isGitHubHost(host):
+		return GitHub, true
+	case host != "" && host == hostEnv("GH_HOST"):
+		return GitHub, true
+	case host == DefaultGitLabHost, strings.HasPrefix(host, "gitlab."):
+		return GitLab, true
+	case host != "" && host == hostEnv("GITLAB_HOST"):
+		return GitLab, true
+	}
+	return "", false
+}
+
+// hostEnv reads a host from a variable that may also hold a URL
+func hostEnv(name string) string {
+	host := strings.TrimSpace(os.Getenv(name))
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "/"))
+}
+
+// ParseRemote reads a git remote in any of the usual forms:
+// git@host:owner/name.git, ssh://git@host:22/owner/name.git and https://host/owner/name.git
+func ParseRemote(remoteURL string) (Remote, error) {
+	host, path, err := splitRemote(strings.TrimSpace(remoteURL))
+	if err != nil {
+		return Remote{}, err
+	}
+
+	kind, ok := KindForHost(host)
+	if !ok {
+		return Remote{}, fmt.Errorf("unsupported forge host %s in %s", host, remoteURL)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || (kind == GitHub && len(parts) != 2) {
+		return Remote{}, fmt.Errorf("could not parse repository path %q in %s", path, remoteURL)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return Remote{}, fmt.Errorf("could not parse repository path %q in %s", path, remoteURL)
+		}
+	}
+
+	return Remote{
+		Kind:  kind,
+		Host:  host,
+		Owner: strings.Join(parts[:len(parts)-1], "/"),
+		Name:  parts[len(parts)-1],
+	}, nil
+}
+
+func splitRemote(remoteURL string) (host, path string, err error) {
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return "", "", fmt.Errorf("parsing remote %s: %w", remoteURL, err)
+		}
+		host = u.Host
+		// The ssh port has nothing to do with where the API lives
+		if u.Scheme == "ssh" || u.Scheme == "git+ssh" {
+			host = u.Hostname()
+		}
+		return strings.ToLower(host), u.Path, nil
+	}
+
+	// scp-like syntax, user@host:path
+	at := strings.Index(remoteURL, "@")
+	colon := strings.Index(remoteURL, ":")
+	if colon < 0 || colon < at {
+		return "", "", fmt.Errorf("unrecognised remote %s", remoteURL)
+	}
+	return strings.ToLower(remoteURL[at+1 : colon]), remoteURL[colon+1:], nil
+}
+
+// Origin returns the origin remote of the repository in dir
+func Origin(dir string) (string, error) {
+	cmd := exec.Command("git", "remote", "get-url", "origin")
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("no git remote found")
+	}
+	return strings.TrimSpace(string(output)), nil
+}
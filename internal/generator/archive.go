@@ -0,0 +1,162 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// ArchiveFormats are the archive formats offered per OS
+var ArchiveFormats = []string{"tar.gz", "zip", "binary"}
+
+// ArchiveOS lists the target operating systems in display order
+var ArchiveOS = []string{"linux", "darwin", "windows"}
+
+// DefaultArchiveNameTemplate matches GoReleaser's default for the amd64/arm64 builds we generate
+const DefaultArchiveNameTemplate = "{{ .ProjectName }}_{{ .Version }}_{{ .Os }}_{{ .Arch }}"
+
+// DefaultArchiveSettings ships license and readme, with zip archives on Windows
+func DefaultArchiveSettings() *models.ArchiveSettings {
+	return &models.ArchiveSettings{
+		Files:   []string{"LICENSE*", "README*"},
+		Formats: map[string]string{"windows": "zip"},
+	}
+}
+
+// ArchiveSettingsFor returns the project's archive settings, or the defaults if none are saved
+func ArchiveSettingsFor(config *models.ProjectConfig) *models.ArchiveSettings {
+	if config == nil || config.Config == nil || config.Config.Archive == nil {
+		return DefaultArchiveSettings()
+	}
+	return config.Config.Archive
+}
+
+// ArchiveFormat returns the archive format for goos, tar.gz when unset
+func ArchiveFormat(settings *models.ArchiveSettings, goos string) string {
+	if settings != nil && settings.Formats[goos] != "" {
+		return settings.Formats[goos]
+	}
+	return "tar.gz"
+}
+
+// ArchiveNameTemplate returns the configured name template or GoReleaser's default
+func ArchiveNameTemplate(settings *models.ArchiveSettings) string {
+	if settings != nil && settings.NameTemplate != "" {
+		return settings.NameTemplate
+	}
+	return DefaultArchiveNameTemplate
+}
+
+// ProjectName is the project_name written to generated configs, so archive
+// names are predictable for package.json
+func ProjectName(project *models.ProjectInfo) string {
+	if project == nil {
+		return ""
+	}
+	if project.Binary != nil && project.Binary.Name != "" {
+		return project.Binary.Name
+	}
+	if project.Repository != nil && project.Repository.Name != "" {
+		return project.Repository.Name
+	}
+	if project.Module != nil && project.Module.Name != "" {
+		return path.Base(project.Module.Name)
+	}
+	return ""
+}
+
+// ProjectLicense is the detected SPDX license, "" when there's no license file or it
+// wasn't recognised. Channels that need one ask for it rather than assuming a license.
+func ProjectLicense(project *models.ProjectInfo) string {
+	if project != nil {
+		return project.License
+	}
+	return ""
+}
+
+var templateFieldRe = regexp.MustCompile(`\{\{\s*(.*?)\s*\}\}`)
+
+// RenderArchiveName expands a name template using vars keyed by field name,
+// e.g. "Os" for {{ .Os }}. Anything beyond plain fields is rejected.
+func RenderArchiveName(template string, vars map[string]string) (string, error) {
+	var unsupported string
+	result := templateFieldRe.ReplaceAllStringFunc(template, func(match string) string {
+		field := strings.TrimPrefix(templateFieldRe.FindStringSubmatch(match)[1], ".")
+		value, ok := vars[field]
+		if !ok && unsupported == "" {
+			unsupported = match
+		}
+		return value
+	})
+	if unsupported != "" {
+		return "", fmt.Errorf("unsupported template expression %s", unsupported)
+	}
+	return result, nil
+}
+
+// ArchiveExtension returns the file extension GoReleaser uses for format
+func ArchiveExtension(format string) string {
+	if format == "binary" {
+		return ""
+	}
+	return "." + format
+}
+
+// PreviewArchiveName renders the archive name for an example release
+func PreviewArchiveName(project *models.ProjectInfo, settings *models.ArchiveSettings, goos, goarch string) (string, error) {
+	name, err := RenderArchiveName(ArchiveNameTemplate(settings), map[string]string{
+		"ProjectName": ProjectName(project),
+		"Binary":      ProjectName(project),
+		"Version":     "1.2.3",
+		"Tag":         "v1.2.3",
+		"Os":          goos,
+		"Arch":        goarch,
+	})
+	if err != nil {
+		return "", err
+	}
+	return name + ArchiveExtension(ArchiveFormat(settings, goos)), nil
+}
+
+// CheckWindowsArchives makes sure Windows archives are something manager can
+// install. Scoop and winget only extract zip files or take a bare exe.
+func CheckWindowsArchives(settings *models.ArchiveSettings, manager string) error {
+	if format := ArchiveFormat(settings, "windows"); format != "zip" && format != "binary" {
+		return fmt.Errorf("%s needs zip or binary archives on windows, got %s", manager, format)
+	}
+	return nil
+}
+
+// NPMArchiveURL builds the golang-npm download URL from the archive settings.
+// golang-npm extracts a tarball into ./bin, so unix archives must be flat tar.gz files.
+func NPMArchiveURL(project *models.ProjectInfo, settings *models.ArchiveSettings) (string, error) {
+	if project == nil || project.Repository == nil {
+		return "", fmt.Errorf("repository information required for npm download url")
+	}
+
+	for _, goos := range []string{"linux", "darwin"} {
+		if format := ArchiveFormat(settings, goos); format != "tar.gz" {
+			return "", fmt.Errorf("npm install needs tar.gz archives, %s is set to %s", goos, format)
+		}
+	}
+	if settings != nil && settings.WrapInDirectory {
+		return "", fmt.Errorf("npm install can't find the binary when archives are wrapped in a directory")
+	}
+
+	name, err := RenderArchiveName(ArchiveNameTemplate(settings), map[string]string{
+		"ProjectName": ProjectName(project),
+		"Binary":      ProjectName(project),
+		"Version":     "{{version}}",
+		"Tag":         "v{{version}}",
+		"Os":          "{{platform}}",
+		"Arch":        "{{arch}}",
+	})
+	if err != nil {
+		return "", fmt.Errorf("archive name can't be used for npm: %w", err)
+	}
+
+	return fmt.Sprintf("%s/releases/download/v{{version}}/%s.tar.gz", ProjectURL(project), name), nil
+}
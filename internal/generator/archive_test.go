@@ -0,0 +1,103 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestNPMArchiveURL(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool-repo"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+
+	tests := []struct {
+		name     string
+		settings *models.ArchiveSettings
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "default template",
+			settings: DefaultArchiveSettings(),
+			want:     "https://github.com/acme/tool-repo/releases/download/v{{version}}/tool_{{version}}_{{platform}}_{{arch}}.tar.gz",
+		},
+		{
+			name:     "custom template",
+			settings: &models.ArchiveSettings{NameTemplate: "{{.ProjectName}}-{{ .Tag }}-{{ .Os }}-{{ .Arch }}"},
+			want:     "https://github.com/acme/tool-repo/releases/download/v{{version}}/tool-v{{version}}-{{platform}}-{{arch}}.tar.gz",
+		},
+		{
+			name:     "template logic",
+			settings: &models.ArchiveSettings{NameTemplate: "{{ .ProjectName }}_{{ if eq .Os \"darwin\" }}macOS{{ end }}"},
+			wantErr:  "unsupported template expression",
+		},
+		{
+			name:     "zip on linux",
+			settings: &models.ArchiveSettings{Formats: map[string]string{"linux": "zip"}},
+			wantErr:  "needs tar.gz",
+		},
+		{
+			name:     "wrapped",
+			settings: &models.ArchiveSettings{WrapInDirectory: true},
+			wantErr:  "wrapped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NPMArchiveURL(project, tt.settings)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NPMArchiveURL failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigArchives(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{
+		Archive: &models.ArchiveSettings{
+			NameTemplate:    "{{ .ProjectName }}_{{ .Os }}_{{ .Arch }}",
+			Files:           []string{"LICENSE", "completions/*"},
+			WrapInDirectory: true,
+			Formats:         map[string]string{"darwin": "zip", "windows": "binary"},
+		},
+	}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+
+	want := `archives:
+  - formats: [tar.gz]
+    name_template: "{{ .ProjectName }}_{{ .Os }}_{{ .Arch }}"
+    wrap_in_directory: true
+    format_overrides:
+      - goos: darwin
+        formats: [zip]
+      - goos: windows
+        formats: [binary]
+    files:
+      - "LICENSE"
+      - "completions/*"
+`
+	if !strings.Contains(content, want) {
+		t.Errorf("Expected archives block:\n%s\ngot:\n%s", want, content)
+	}
+}
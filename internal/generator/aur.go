@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// AURKeyEnv holds the path of the AUR SSH key while GoReleaser runs, so the
+// generated config doesn't contain a path from the maintainer's machine
+const AURKeyEnv = "AUR_KEY"
+
+// DefaultAURKeyPath is where the AUR wiki suggests keeping the key
+const DefaultAURKeyPath = "~/.ssh/aur"
+
+var aurPackageRe = regexp.MustCompile(`^[a-z0-9@_+][a-z0-9@._+-]*$`)
+
+var maintainerRe = regexp.MustCompile(`^[^<>]+ <[^<>@\s]+@[^<>\s]+>$`)
+
+// DefaultAURConfig names the package <binary>-bin, the AUR convention for prebuilt binaries
+func DefaultAURConfig(project *models.ProjectInfo, maintainer string) *models.AURConfig {
+	config := &models.AURConfig{
+		Maintainer:     maintainer,
+		License:        ProjectLicense(project),
+		PrivateKeyPath: DefaultAURKeyPath,
+	}
+	if name := ProjectName(project); name != "" {
+		config.PackageName = strings.ToLower(name) + "-bin"
+	}
+	return config
+}
+
+// Maintainer formats a git identity the way PKGBUILD and deb maintainer fields expect
+func Maintainer(name, email string) string {
+	if name == "" || email == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s <%s>", name, email)
+}
+
+// ValidateAURConfig checks the fields the AUR and makepkg insist on
+func ValidateAURConfig(config *models.AURConfig) error {
+	if config == nil {
+		return fmt.Errorf("aur settings missing")
+	}
+	if !aurPackageRe.MatchString(config.PackageName) {
+		return fmt.Errorf("aur package name %q may only contain lowercase letters, digits and @._+-", config.PackageName)
+	}
+	if !maintainerRe.MatchString(config.Maintainer) {
+		return fmt.Errorf("aur maintainer %q must look like 'Name <email>'", config.Maintainer)
+	}
+	if strings.TrimSpace(config.License) == "" {
+		return fmt.Errorf("aur license is required")
+	}
+	if strings.TrimSpace(config.PrivateKeyPath) == "" {
+		return fmt.Errorf("aur private key path is required")
+	}
+	return nil
+}
+
+func writeAURSection(b *strings.Builder, project *models.ProjectInfo, config *models.AURConfig, archive *models.ArchiveSettings, completions *models.CompletionSettings) error {
+	if err := ValidateAURConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for aur distribution")
+	}
+	// package() runs in the extracted archive and expects the binary at the top
+	if archive != nil && archive.WrapInDirectory {
+		return fmt.Errorf("aur packages need archives that aren't wrapped in a directory")
+	}
+
+	binary := ProjectName(project)
+
+	b.WriteString("aurs:\n")
+	b.WriteString("  - name: " + config.PackageName + "\n")
+	b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+	b.WriteString("    description: \"" + binary + "\"\n")
+	b.WriteString("    maintainers:\n")
+	b.WriteString(fmt.Sprintf("      - %q\n", config.Maintainer))
+	b.WriteString(fmt.Sprintf("    license: %q\n", config.License))
+	b.WriteString("    private_key: \"{{ .Env." + AURKeyEnv + " }}\"\n")
+	b.WriteString("    git_url: \"ssh://aur@aur.archlinux.org/" + config.PackageName + ".git\"\n")
+	if config.PackageName != binary {
+		b.WriteString("    provides:\n")
+		b.WriteString("      - " + binary + "\n")
+		b.WriteString("    conflicts:\n")
+		b.WriteString("      - " + binary + "\n")
+	}
+	b.WriteString("    commit_author:\n")
+	b.WriteString("      name: distui\n")
+	b.WriteString("      email: distui@users.noreply.github.com\n")
+	b.WriteString("    package: |-\n")
+	for _, line := range AURPackageLines(binary, completions) {
+		b.WriteString("      " + line + "\n")
+	}
+	b.WriteString("\n")
+	return nil
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestValidateAURConfig(t *testing.T) {
+	valid := models.AURConfig{PackageName: "tool-bin", Maintainer: "Jane Doe <jane@example.com>", License: "MIT", PrivateKeyPath: "~/.ssh/aur"}
+
+	tests := []struct {
+		name    string
+		modify  func(c *models.AURConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *models.AURConfig) {}, false},
+		{"uppercase name", func(c *models.AURConfig) { c.PackageName = "Tool-bin" }, true},
+		{"leading dash", func(c *models.AURConfig) { c.PackageName = "-tool" }, true},
+		{"maintainer without email", func(c *models.AURConfig) { c.Maintainer = "Jane Doe" }, true},
+		{"missing key", func(c *models.AURConfig) { c.PrivateKeyPath = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateAURConfig(&config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAURConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigAUR(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+		License:    "MIT",
+	}
+	aur := DefaultAURConfig(project, Maintainer("Jane Doe", "jane@example.com"))
+	aur.Enabled = true
+
+	content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{AUR: aur},
+		Completions:   &models.CompletionSettings{ManPages: true},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"aurs:\n  - name: tool-bin\n",
+		`      - "Jane Doe <jane@example.com>"`,
+		`    private_key: "{{ .Env.AUR_KEY }}"`,
+		`    git_url: "ssh://aur@aur.archlinux.org/tool-bin.git"`,
+		"    provides:\n      - tool\n",
+		`      install -Dm755 "./tool" "${pkgdir}/usr/bin/tool"`,
+		`      install -Dm644 "./manpages/tool.1.gz" "${pkgdir}/usr/share/man/man1/tool.1.gz"`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+
+	if strings.Contains(content, "~/.ssh") {
+		t.Errorf("Generated config should not contain the local key path\n%s", content)
+	}
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"fmt"
+
+	"distui/internal/models"
+)
+
+// Staging directories the before hooks write into, shipped inside the archives
+const (
+	CompletionsDir = "completions"
+	ManPagesDir    = "manpages"
+)
+
+// CompletionSettingsFor returns the project's completion settings, or nil if nothing is shipped
+func CompletionSettingsFor(config *models.ProjectConfig) *models.CompletionSettings {
+	if config == nil || config.Config == nil || config.Config.Completions == nil {
+		return nil
+	}
+	settings := config.Config.Completions
+	if !settings.Enabled && !settings.ManPages {
+		return nil
+	}
+	return settings
+}
+
+// CompletionMain is the main package the hooks run
+func CompletionMain(settings *models.CompletionSettings) string {
+	if settings == nil || settings.Main == "" {
+		return "."
+	}
+	return settings.Main
+}
+
+// CompletionHooks returns the before hooks that generate completion scripts and
+// man pages. They run the CLI's own "completion <shell>" and "man" commands, which
+// Configure only offers once it has seen them in the built binary.
+func CompletionHooks(binary string, settings *models.CompletionSettings) []string {
+	if settings == nil {
+		return nil
+	}
+
+	main := CompletionMain(settings)
+	var hooks []string
+	if settings.Enabled {
+		hooks = append(hooks, fmt.Sprintf(`sh -c "rm -rf %[1]s && mkdir -p %[1]s && for sh in bash zsh fish; do go run %[3]s completion $sh > %[1]s/%[2]s.$sh; done"`, CompletionsDir, binary, main))
+	}
+	if settings.ManPages {
+		hooks = append(hooks, fmt.Sprintf(`sh -c "rm -rf %[1]s && mkdir -p %[1]s && go run %[3]s man | gzip -c -9 > %[1]s/%[2]s.1.gz"`, ManPagesDir, binary, main))
+	}
+	return hooks
+}
+
+// CompletionArchiveFiles returns the globs that put the staged files into archives
+func CompletionArchiveFiles(settings *models.CompletionSettings) []string {
+	if settings == nil {
+		return nil
+	}
+
+	var files []string
+	if settings.Enabled {
+		files = append(files, CompletionsDir+"/*")
+	}
+	if settings.ManPages {
+		files = append(files, ManPagesDir+"/*")
+	}
+	return files
+}
+
+// BrewInstallLines returns the formula install block, including completions and man pages
+func BrewInstallLines(binary string, settings *models.CompletionSettings) []string {
+	lines := []string{fmt.Sprintf("bin.install %q", binary)}
+	if settings == nil {
+		return lines
+	}
+
+	if settings.Enabled {
+		lines = append(lines,
+			fmt.Sprintf(`bash_completion.install "%s/%s.bash" => %q`, CompletionsDir, binary, binary),
+			fmt.Sprintf(`zsh_completion.install "%s/%s.zsh" => "_%s"`, CompletionsDir, binary, binary),
+			fmt.Sprintf(`fish_completion.install "%s/%s.fish"`, CompletionsDir, binary),
+		)
+	}
+	if settings.ManPages {
+		lines = append(lines, fmt.Sprintf(`man1.install "%s/%s.1.gz"`, ManPagesDir, binary))
+	}
+	return lines
+}
+
+// AURPackageLines returns the PKGBUILD package() body, including completions and man pages
+func AURPackageLines(binary string, settings *models.CompletionSettings) []string {
+	lines := []string{fmt.Sprintf(`install -Dm755 "./%[1]s" "${pkgdir}/usr/bin/%[1]s"`, binary)}
+	if settings == nil {
+		return lines
+	}
+
+	if settings.Enabled {
+		lines = append(lines,
+			fmt.Sprintf(`install -Dm644 "./%s/%[2]s.bash" "${pkgdir}/usr/share/bash-completion/completions/%[2]s"`, CompletionsDir, binary),
+			fmt.Sprintf(`install -Dm644 "./%s/%[2]s.zsh" "${pkgdir}/usr/share/zsh/site-functions/_%[2]s"`, CompletionsDir, binary),
+			fmt.Sprintf(`install -Dm644 "./%s/%[2]s.fish" "${pkgdir}/usr/share/fish/vendor_completions.d/%[2]s.fish"`, CompletionsDir, binary),
+		)
+	}
+	if settings.ManPages {
+		lines = append(lines, fmt.Sprintf(`install -Dm644 "./%s/%[2]s.1.gz" "${pkgdir}/usr/share/man/man1/%[2]s.1.gz"`, ManPagesDir, binary))
+	}
+	return lines
+}
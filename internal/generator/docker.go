@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"distui/internal/models"
+)
+
+// GeneratedDockerfile is written when the project has no Dockerfile GoReleaser can use
+const GeneratedDockerfile = "goreleaser.Dockerfile"
+
+// DefaultRegistry is where images go unless configured otherwise
+const DefaultRegistry = "ghcr.io"
+
+// DockerPlatforms are the image platforms offered, the builds cover both
+var DockerPlatforms = []string{"linux/amd64", "linux/arm64"}
+
+// DockerBaseImages maps the base image choices of the generated Dockerfile to images.
+// distroless/static brings CA certificates, tzdata and a nonroot user, scratch brings nothing.
+var DockerBaseImages = map[string]string{
+	"distroless": "gcr.io/distroless/static-debian12:nonroot",
+	"scratch":    "scratch",
+}
+
+// Lowercase path components, the OCI distribution spec rules for repository names
+var dockerImageRe = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// DefaultDockerConfig publishes to ghcr.io under the repository name
+func DefaultDockerConfig(project *models.ProjectInfo, dockerfile string) *models.DockerConfig {
+	if dockerfile == "" {
+		dockerfile = GeneratedDockerfile
+	}
+	config := &models.DockerConfig{
+		Registry:   DefaultRegistry,
+		Image:      strings.ToLower(ProjectName(project)),
+		Dockerfile: dockerfile,
+		BaseImage:  "distroless",
+		Platforms:  append([]string{}, DockerPlatforms...),
+	}
+	if project != nil && project.Repository != nil && project.Repository.Owner != "" {
+		config.Image = strings.ToLower(project.Repository.Owner + "/" + project.Repository.Name)
+	}
+	return config
+}
+
+// DockerfilePath returns the Dockerfile the project releases with
+func DockerfilePath(config *models.ProjectConfig) string {
+	if config != nil && config.Config != nil && config.Config.Distributions.Docker != nil &&
+		config.Config.Distributions.Docker.Dockerfile != "" {
+		return config.Config.Distributions.Docker.Dockerfile
+	}
+	return GeneratedDockerfile
+}
+
+// DockerImage returns the full image name without a tag
+func DockerImage(config *models.DockerConfig) string {
+	return config.Registry + "/" + config.Image
+}
+
+// DockerImageTags returns the tags a release pushes, the multi-arch manifests.
+// Prereleases don't move latest.
+func DockerImageTags(config *models.DockerConfig, version string) []string {
+	image := DockerImage(config)
+	tags := []string{image + ":" + strings.TrimPrefix(version, "v")}
+	if semver.Prerelease("v"+strings.TrimPrefix(version, "v")) == "" {
+		tags = append(tags, image+":latest")
+	}
+	return tags
+}
+
+// ValidateDockerConfig checks the image name before the registry rejects the push
+func ValidateDockerConfig(config *models.DockerConfig) error {
+	if config == nil {
+		return fmt.Errorf("container image settings missing")
+	}
+	if config.Registry == "" || strings.Contains(config.Registry, "/") {
+		return fmt.Errorf("registry %q must be a host like %s", config.Registry, DefaultRegistry)
+	}
+	if !dockerImageRe.MatchString(config.Image) {
+		return fmt.Errorf("image name %q must be lowercase, e.g. owner/tool", config.Image)
+	}
+	if config.Dockerfile == "" {
+		return fmt.Errorf("dockerfile path is required")
+	}
+	if _, ok := DockerBaseImages[config.BaseImage]; !ok && config.Dockerfile == GeneratedDockerfile {
+		return fmt.Errorf("base image must be distroless or scratch, got %q", config.BaseImage)
+	}
+	if len(config.Platforms) == 0 {
+		return fmt.Errorf("select at least one image platform")
+	}
+	for _, platform := range config.Platforms {
+		if !containsString(DockerPlatforms, platform) {
+			return fmt.Errorf("unsupported platform %q, expected one of %s", platform, strings.Join(DockerPlatforms, ", "))
+		}
+	}
+	return nil
+}
+
+// GenerateDockerfile returns a minimal Dockerfile around the binary GoReleaser built
+func GenerateDockerfile(project *models.ProjectInfo, config *models.DockerConfig) (string, error) {
+	base, ok := DockerBaseImages[config.BaseImage]
+	if !ok {
+		return "", fmt.Errorf("base image must be distroless or scratch, got %q", config.BaseImage)
+	}
+	binary := ProjectName(project)
+
+	var b strings.Builder
+	b.WriteString("# Generated by distui\n")
+	b.WriteString("# GoReleaser puts the built binary into the build context\n")
+	b.WriteString("FROM " + base + "\n")
+	b.WriteString(fmt.Sprintf("COPY %s /usr/bin/%s\n", binary, binary))
+	b.WriteString(fmt.Sprintf("ENTRYPOINT [\"/usr/bin/%s\"]\n", binary))
+	return b.String(), nil
+}
+
+func writeDockerSection(b *strings.Builder, project *models.ProjectInfo, config *models.DockerConfig) error {
+	if err := ValidateDockerConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for container images")
+	}
+
+	image := DockerImage(config)
+	source := ProjectURL(project)
+
+	b.WriteString("dockers:\n")
+	for _, platform := range config.Platforms {
+		goarch := strings.TrimPrefix(platform, "linux/")
+		b.WriteString("  - image_templates:\n")
+		b.WriteString(fmt.Sprintf("      - \"%s:{{ .Version }}-%s\"\n", image, goarch))
+		// Empty templates are dropped, so prereleases only get the version tag
+		b.WriteString(fmt.Sprintf("      - \"{{ if not .Prerelease }}%s:latest-%s{{ end }}\"\n", image, goarch))
+		b.WriteString("    use: buildx\n")
+		b.WriteString("    goos: linux\n")
+		b.WriteString("    goarch: " + goarch + "\n")
+		b.WriteString("    dockerfile: " + config.Dockerfile + "\n")
+		b.WriteString("    build_flag_templates:\n")
+		b.WriteString("      - \"--platform=" + platform + "\"\n")
+		b.WriteString("      - \"--label=org.opencontainers.image.source=" + source + "\"\n")
+		b.WriteString("      - \"--label=org.opencontainers.image.version={{ .Version }}\"\n")
+		b.WriteString("      - \"--label=org.opencontainers.image.revision={{ .FullCommit }}\"\n")
+		b.WriteString("      - \"--label=org.opencontainers.image.created={{ .Date }}\"\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString("docker_manifests:\n")
+	for _, tag := range []string{"{{ .Version }}", "latest"} {
+		b.WriteString(fmt.Sprintf("  - name_template: \"%s:%s\"\n", image, tag))
+		b.WriteString("    image_templates:\n")
+		for _, platform := range config.Platforms {
+			b.WriteString(fmt.Sprintf("      - \"%s:%s-%s\"\n", image, tag, strings.TrimPrefix(platform, "linux/")))
+		}
+		if tag == "latest" {
+			b.WriteString("    skip_push: auto\n")
+		}
+	}
+	b.WriteString("\n")
+	return nil
+}
@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestValidateDockerConfig(t *testing.T) {
+	valid := models.DockerConfig{Registry: "ghcr.io", Image: "acme/tool", Dockerfile: GeneratedDockerfile,
+		BaseImage: "distroless", Platforms: []string{"linux/amd64"}}
+
+	tests := []struct {
+		name    string
+		modify  func(c *models.DockerConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *models.DockerConfig) {}, false},
+		{"uppercase image", func(c *models.DockerConfig) { c.Image = "Acme/tool" }, true},
+		{"registry with path", func(c *models.DockerConfig) { c.Registry = "ghcr.io/acme" }, true},
+		{"unknown base", func(c *models.DockerConfig) { c.BaseImage = "alpine" }, true},
+		{"own dockerfile ignores base", func(c *models.DockerConfig) { c.Dockerfile = "Dockerfile"; c.BaseImage = "" }, false},
+		{"no platforms", func(c *models.DockerConfig) { c.Platforms = nil }, true},
+		{"unsupported platform", func(c *models.DockerConfig) { c.Platforms = []string{"windows/amd64"} }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateDockerConfig(&config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDockerConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigDocker(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "Acme", Name: "Tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	docker := DefaultDockerConfig(project, "")
+	docker.Enabled = true
+
+	content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{Docker: docker},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"dockers:\n  - image_templates:\n      - \"ghcr.io/acme/tool:{{ .Version }}-amd64\"\n",
+		"    goarch: arm64\n    dockerfile: goreleaser.Dockerfile\n",
+		`      - "--platform=linux/arm64"`,
+		"docker_manifests:\n  - name_template: \"ghcr.io/acme/tool:{{ .Version }}\"\n    image_templates:\n      - \"ghcr.io/acme/tool:{{ .Version }}-amd64\"\n      - \"ghcr.io/acme/tool:{{ .Version }}-arm64\"\n",
+		`  - name_template: "ghcr.io/acme/tool:latest"`,
+		`      - "{{ if not .Prerelease }}ghcr.io/acme/tool:latest-amd64{{ end }}"`,
+		"-arm64\"\n    skip_push: auto\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+
+	tags := DockerImageTags(docker, "v1.2.3")
+	if len(tags) != 2 || tags[0] != "ghcr.io/acme/tool:1.2.3" || tags[1] != "ghcr.io/acme/tool:latest" {
+		t.Errorf("DockerImageTags() = %v", tags)
+	}
+
+	// Prereleases leave latest alone
+	tags = DockerImageTags(docker, "v2.0.0-rc.1")
+	if len(tags) != 1 || tags[0] != "ghcr.io/acme/tool:2.0.0-rc.1" {
+		t.Errorf("DockerImageTags() for a prerelease = %v", tags)
+	}
+}
+
+func TestGenerateDockerfile(t *testing.T) {
+	project := &models.ProjectInfo{Binary: &models.BinaryInfo{Name: "tool"}}
+
+	for base, from := range DockerBaseImages {
+		content, err := GenerateDockerfile(project, &models.DockerConfig{BaseImage: base})
+		if err != nil {
+			t.Fatalf("GenerateDockerfile(%s) failed: %v", base, err)
+		}
+		for _, want := range []string{"# Generated by distui\n", "FROM " + from + "\n", "COPY tool /usr/bin/tool\n", `ENTRYPOINT ["/usr/bin/tool"]`} {
+			if !strings.Contains(content, want) {
+				t.Errorf("Expected %s Dockerfile to contain %q\n%s", base, want, content)
+			}
+		}
+	}
+}
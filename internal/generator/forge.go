@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/internal/forge"
+	"distui/internal/models"
+)
+
+// ProjectForge is the forge the project releases to, GitHub unless detection said otherwise
+func ProjectForge(project *models.ProjectInfo) forge.Kind {
+	if project != nil && project.Repository != nil && project.Repository.Forge != "" {
+		return forge.Kind(project.Repository.Forge)
+	}
+	return forge.GitHub
+}
+
+// ProjectHost is the host of the project's repository
+func ProjectHost(project *models.ProjectInfo) string {
+	if project != nil && project.Repository != nil && project.Repository.Host != "" {
+		return project.Repository.Host
+	}
+	if ProjectForge(project) == forge.GitLab {
+		return forge.DefaultGitLabHost
+	}
+	return forge.DefaultGitHubHost
+}
+
+// ProjectURL is the repository's web page, used as homepage in package manifests
+func ProjectURL(project *models.ProjectInfo) string {
+	if project == nil || project.Repository == nil {
+		return ""
+	}
+	return "https://" + ProjectHost(project) + "/" + project.Repository.Owner + "/" + project.Repository.Name
+}
+
+// ForgeTokenEnv is the variable GoReleaser reads the project's forge token from
+func ForgeTokenEnv(project *models.ProjectInfo) string {
+	kind := ProjectForge(project)
+	if kind == forge.GitLab {
+		return "GITLAB_TOKEN"
+	}
+	if kind.IsGitea() {
+		return "GITEA_TOKEN"
+	}
+	return "GITHUB_TOKEN"
+}
+
+// TokenTemplate is the token setting for repositories GoReleaser pushes to. They are
+// reached through the same forge client as the release, so they share its token.
+func TokenTemplate(project *models.ProjectInfo) string {
+	return fmt.Sprintf("\"{{ .Env.%s }}\"", ForgeTokenEnv(project))
+}
+
+// writeForgeURLs points GoReleaser at a GitHub Enterprise Server, a self-managed GitLab
+// or the Gitea/Forgejo instance. github.com and gitlab.com need nothing.
+func writeForgeURLs(b *strings.Builder, project *models.ProjectInfo) {
+	kind := ProjectForge(project)
+	host := ProjectHost(project)
+	switch {
+	case kind == forge.GitHub && host != forge.DefaultGitHubHost:
+		b.WriteString("github_urls:\n")
+		b.WriteString(fmt.Sprintf("  api: %s/\n", forge.APIURL(kind, host)))
+		b.WriteString(fmt.Sprintf("  upload: https://%s/api/uploads/\n", host))
+		b.WriteString(fmt.Sprintf("  download: https://%s/\n\n", host))
+		return
+	case kind == forge.GitLab && host != forge.DefaultGitLabHost:
+		b.WriteString("gitlab_urls:\n")
+	case kind.IsGitea():
+		b.WriteString("gitea_urls:\n")
+	default:
+		return
+	}
+	b.WriteString(fmt.Sprintf("  api: %s/\n", forge.APIURL(kind, host)))
+	b.WriteString(fmt.Sprintf("  download: https://%s\n\n", host))
+}
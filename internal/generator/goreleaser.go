@@ -17,7 +17,7 @@ func GenerateGoReleaserConfig(project *models.ProjectInfo, config *models.Projec
 	var b strings.Builder
 
 	if project.Repository != nil {
-		b.WriteString(fmt.Sprintf("# Generated by distui - https://github.com/%s/%s\n", project.Repository.Owner, project.Repository.Name))
+		b.WriteString(fmt.Sprintf("# Generated by distui - %s\n", ProjectURL(project)))
 	} else {
 		b.WriteString("# Generated by distui\n")
 	}
@@ -25,12 +25,24 @@ func GenerateGoReleaserConfig(project *models.ProjectInfo, config *models.Projec
 
 	b.WriteString("version: 2\n\n")
 
+	// Pin the project name so archive names (and the npm download url) don't depend on the checkout directory
+	if name := ProjectName(project); name != "" {
+		b.WriteString(fmt.Sprintf("project_name: %s\n\n", name))
+	}
+
+	writeForgeURLs(&b, project)
+
 	b.WriteString("before:\n")
 	b.WriteString("  hooks:\n")
 	if config.Config != nil && config.Config.Release != nil && !config.Config.Release.SkipTests {
 		b.WriteString("    - go test ./...\n")
 	}
-	b.WriteString("    - go mod tidy\n\n")
+	b.WriteString("    - go mod tidy\n")
+	completions := CompletionSettingsFor(config)
+	for _, hook := range CompletionHooks(ProjectName(project), completions) {
+		b.WriteString(fmt.Sprintf("    - '%s'\n", hook))
+	}
+	b.WriteString("\n")
 
 	b.WriteString("builds:\n")
 	b.WriteString("  - env:\n")
@@ -48,12 +60,49 @@ func GenerateGoReleaserConfig(project *models.ProjectInfo, config *models.Projec
 	}
 	b.WriteString("\n")
 
+	archive := ArchiveSettingsFor(config)
+	defaultFormat := ArchiveFormat(archive, "linux")
 	b.WriteString("archives:\n")
-	b.WriteString("  - format_overrides:\n")
-	b.WriteString("      - goos: windows\n")
-	b.WriteString("        format: zip\n")
+	b.WriteString(fmt.Sprintf("  - formats: [%s]\n", defaultFormat))
+	if archive.NameTemplate != "" {
+		b.WriteString(fmt.Sprintf("    name_template: %q\n", archive.NameTemplate))
+	}
+	if archive.WrapInDirectory {
+		b.WriteString("    wrap_in_directory: true\n")
+	}
+	var overrides []string
+	for _, goos := range ArchiveOS {
+		if format := ArchiveFormat(archive, goos); format != defaultFormat {
+			overrides = append(overrides, fmt.Sprintf("      - goos: %s\n        formats: [%s]\n", goos, format))
+		}
+	}
+	if len(overrides) > 0 {
+		b.WriteString("    format_overrides:\n")
+		b.WriteString(strings.Join(overrides, ""))
+	}
 	b.WriteString("    files:\n")
-	b.WriteString("      - none*\n\n")
+	archiveFiles := append(append([]string{}, archive.Files...), CompletionArchiveFiles(completions)...)
+	if len(archiveFiles) == 0 {
+		// GoReleaser adds README/LICENSE by default, none* opts out
+		b.WriteString("      - none*\n\n")
+	} else {
+		for _, file := range archiveFiles {
+			b.WriteString(fmt.Sprintf("      - %q\n", file))
+		}
+		b.WriteString("\n")
+	}
+
+	// GoReleaser's release defaults are fine unless the release is a draft or a prerelease
+	if config.Config != nil && config.Config.Release != nil && (config.Config.Release.CreateDraft || config.Config.Release.PreRelease) {
+		b.WriteString("release:\n")
+		if config.Config.Release.CreateDraft {
+			b.WriteString("  draft: true\n")
+		}
+		if config.Config.Release.PreRelease {
+			b.WriteString("  prerelease: true\n")
+		}
+		b.WriteString("\n")
+	}
 
 	b.WriteString("checksum:\n")
 	b.WriteString("  name_template: 'checksums.txt'\n\n")
@@ -70,38 +119,77 @@ func GenerateGoReleaserConfig(project *models.ProjectInfo, config *models.Projec
 	}
 
 	if config.Config != nil && config.Config.Distributions.Homebrew != nil && config.Config.Distributions.Homebrew.Enabled {
-		tapRepo := config.Config.Distributions.Homebrew.TapRepo
-		tapParts := strings.Split(tapRepo, "/")
+		if err := writeHomebrewSection(&b, project, config.Config.Distributions.Homebrew, completions); err != nil {
+			return "", err
+		}
+	}
 
-		if len(tapParts) != 2 || tapParts[0] == "" || tapParts[1] == "" {
-			return "", fmt.Errorf("invalid homebrew tap repo format: expected 'owner/repo', got '%s'", tapRepo)
+	if config.Config != nil && config.Config.Distributions.Scoop != nil && config.Config.Distributions.Scoop.Enabled {
+		bucketParts := strings.Split(config.Config.Distributions.Scoop.BucketRepo, "/")
+		if len(bucketParts) != 2 || bucketParts[0] == "" || bucketParts[1] == "" {
+			return "", fmt.Errorf("invalid scoop bucket repo format: expected 'owner/repo', got '%s'", config.Config.Distributions.Scoop.BucketRepo)
 		}
 
 		if project.Repository == nil {
-			return "", fmt.Errorf("repository information required for homebrew distribution")
+			return "", fmt.Errorf("repository information required for scoop distribution")
 		}
 
-		brewName := project.Repository.Name
-		if project.Binary != nil && project.Binary.Name != "" {
-			brewName = project.Binary.Name
+		if err := CheckWindowsArchives(archive, "scoop"); err != nil {
+			return "", err
 		}
 
-		b.WriteString("brews:\n")
-		b.WriteString("  - name: " + brewName + "\n")
+		scoopName := ScoopManifestName(project, config.Config.Distributions.Scoop)
+
+		b.WriteString("scoops:\n")
+		b.WriteString("  - name: " + scoopName + "\n")
 		b.WriteString("    repository:\n")
-		b.WriteString(fmt.Sprintf("      owner: %s\n", tapParts[0]))
-		b.WriteString(fmt.Sprintf("      name: %s\n", tapParts[1]))
-		b.WriteString("      token: \"{{ .Env.GITHUB_TOKEN }}\"\n")
-		b.WriteString("    homepage: https://github.com/" + project.Repository.Owner + "/" + project.Repository.Name + "\n")
-		b.WriteString("    description: \"" + brewName + "\"\n")
-		b.WriteString("    license: MIT\n")
-		b.WriteString("    directory: Formula\n")
+		b.WriteString(fmt.Sprintf("      owner: %s\n", bucketParts[0]))
+		b.WriteString(fmt.Sprintf("      name: %s\n", bucketParts[1]))
+		b.WriteString("      token: " + TokenTemplate(project) + "\n")
+		b.WriteString("    directory: bucket\n")
+		b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+		b.WriteString("    description: \"" + scoopName + "\"\n")
+		if license := ProjectLicense(project); license != "" {
+			b.WriteString(fmt.Sprintf("    license: %q\n", license))
+		}
 		b.WriteString("    commit_author:\n")
 		b.WriteString("      name: distui\n")
 		b.WriteString("      email: distui@users.noreply.github.com\n")
-		b.WriteString("    commit_msg_template: \"Brew formula update for " + brewName + " version {{ .Tag }}\"\n")
-		b.WriteString("    test: |\n")
-		b.WriteString("      system \"#{bin}/" + brewName + "\", \"--version\"\n\n")
+		b.WriteString("    commit_msg_template: \"Scoop update for " + scoopName + " version {{ .Tag }}\"\n\n")
+	}
+
+	if config.Config != nil && config.Config.Distributions.Winget != nil && config.Config.Distributions.Winget.Enabled {
+		if err := CheckWindowsArchives(archive, "winget"); err != nil {
+			return "", err
+		}
+		if err := writeWingetSection(&b, project, config.Config.Distributions.Winget); err != nil {
+			return "", err
+		}
+	}
+
+	if config.Config != nil && config.Config.Distributions.AUR != nil && config.Config.Distributions.AUR.Enabled {
+		if err := writeAURSection(&b, project, config.Config.Distributions.AUR, archive, completions); err != nil {
+			return "", err
+		}
+	}
+
+	if config.Config != nil && config.Config.Distributions.NFPM != nil && config.Config.Distributions.NFPM.Enabled {
+		if err := writeNFPMSection(&b, project, config.Config.Distributions.NFPM, completions); err != nil {
+			return "", err
+		}
+	}
+
+	if config.Config != nil && config.Config.Distributions.Docker != nil && config.Config.Distributions.Docker.Enabled {
+		if err := writeDockerSection(&b, project, config.Config.Distributions.Docker); err != nil {
+			return "", err
+		}
+	}
+
+	// flake mode builds from source, only the NUR derivation comes from GoReleaser
+	if config.Config != nil && config.Config.Distributions.Nix != nil && config.Config.Distributions.Nix.Enabled && config.Config.Distributions.Nix.Mode == "nur" {
+		if err := writeNixSection(&b, project, config.Config.Distributions.Nix, completions); err != nil {
+			return "", err
+		}
 	}
 
 	if config.Config != nil && config.Config.Distributions.NPM != nil && config.Config.Distributions.NPM.Enabled {
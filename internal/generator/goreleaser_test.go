@@ -0,0 +1,310 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/forge"
+	"distui/internal/goreleaser"
+	"distui/internal/models"
+)
+
+func TestGenerateGoReleaserConfigPassesSchema(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+
+	tests := []struct {
+		name       string
+		settings   *models.ProjectSettings
+		deprecated bool
+	}{
+		{"defaults", &models.ProjectSettings{}, false},
+		{"homebrew and changelog", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "cask"},
+			},
+			Release: &models.ReleaseSettings{GenerateChangelog: true, PreRelease: true},
+		}, false},
+		{"completions and man pages", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "cask"},
+			},
+			Completions: &models.CompletionSettings{Enabled: true, ManPages: true, Framework: "cobra"},
+		}, false},
+		{"homebrew formula", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "formula"},
+			},
+			Completions: &models.CompletionSettings{Enabled: true, ManPages: true, Framework: "cobra"},
+		}, true},
+		{"scoop", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Scoop: &models.ScoopConfig{Enabled: true, BucketRepo: "acme/scoop-bucket"},
+			},
+		}, false},
+		{"winget", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Winget: &models.WingetConfig{Enabled: true, PackageIdentifier: "Acme.Tool", Publisher: "Acme",
+					License: "MIT", ShortDescription: "A tool", ForkRepo: "acme/winget-pkgs"},
+			},
+		}, false},
+		{"aur", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				AUR: &models.AURConfig{Enabled: true, PackageName: "tool-bin", Maintainer: "Jane Doe <jane@example.com>",
+					License: "MIT", PrivateKeyPath: "~/.ssh/aur"},
+			},
+			Completions: &models.CompletionSettings{Enabled: true, Framework: "cobra"},
+		}, false},
+		{"nfpm", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				NFPM: &models.NFPMConfig{Enabled: true, Formats: []string{"deb", "rpm", "apk"}, Maintainer: "Jane Doe <jane@example.com>",
+					Vendor: "acme", License: "MIT", Dependencies: []string{"git"},
+					ConfigFiles:  []models.NFPMContent{{Src: "config.yaml", Dst: "/etc/tool/config.yaml"}},
+					SystemdUnits: []string{"packaging/tool.service"},
+					Scripts:      models.NFPMScripts{PostInstall: "packaging/postinstall.sh"}},
+			},
+			Completions: &models.CompletionSettings{Enabled: true, ManPages: true, Framework: "cobra"},
+		}, false},
+		{"docker", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Docker: &models.DockerConfig{Enabled: true, Registry: "ghcr.io", Image: "acme/tool",
+					Dockerfile: "goreleaser.Dockerfile", BaseImage: "distroless", Platforms: []string{"linux/amd64", "linux/arm64"}},
+			},
+		}, false},
+		{"nix", &models.ProjectSettings{
+			Distributions: models.Distributions{
+				Nix: &models.NixConfig{Enabled: true, Mode: "nur", Repository: "acme/nur-packages",
+					Path: "pkgs/tool/default.nix", License: "mit", Description: "A tool"},
+			},
+			Completions: &models.CompletionSettings{Enabled: true, ManPages: true, Framework: "cobra"},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Project: project, Config: tt.settings})
+			if err != nil {
+				t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+			}
+
+			issues, err := goreleaser.Validate([]byte(content))
+			if err != nil {
+				t.Fatalf("Validate failed: %v", err)
+			}
+			if errs := goreleaser.Errors(issues); len(errs) > 0 {
+				t.Errorf("Generated config has schema errors: %v", errs)
+			}
+			if !tt.deprecated && goreleaser.HasFixable(issues) {
+				t.Errorf("Generated config uses deprecated keys: %v", issues)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigCompletions(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{
+			Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "formula"},
+		},
+		Completions: &models.CompletionSettings{Enabled: true, ManPages: true},
+	}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`go run . completion $sh > completions/tool.$sh`,
+		`go run . man | gzip -c -9 > manpages/tool.1.gz`,
+		`      - "completions/*"`,
+		`      - "manpages/*"`,
+		`      bash_completion.install "completions/tool.bash" => "tool"`,
+		`      zsh_completion.install "completions/tool.zsh" => "_tool"`,
+		`      man1.install "manpages/tool.1.gz"`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+}
+
+func TestGenerateGoReleaserConfigCompletionsMain(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{
+		Completions: &models.CompletionSettings{Enabled: true, ManPages: true, Main: "./cmd/tool"},
+	}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`go run ./cmd/tool completion $sh > completions/tool.$sh`,
+		`go run ./cmd/tool man | gzip -c -9 > manpages/tool.1.gz`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+}
+
+func TestGenerateGoReleaserConfigRelease(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+
+	tests := []struct {
+		name    string
+		release *models.ReleaseSettings
+		want    string
+	}{
+		{"no settings", nil, ""},
+		{"defaults", &models.ReleaseSettings{GenerateChangelog: true}, ""},
+		{"draft", &models.ReleaseSettings{CreateDraft: true}, "release:\n  draft: true\n\n"},
+		{"prerelease", &models.ReleaseSettings{PreRelease: true}, "release:\n  prerelease: true\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &models.ProjectConfig{Config: &models.ProjectSettings{Release: tt.release}}
+			content, err := GenerateGoReleaserConfig(project, config)
+			if err != nil {
+				t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+			}
+			if tt.want == "" && strings.Contains(content, "release:") {
+				t.Errorf("Expected no release section\n%s", content)
+			}
+			if tt.want != "" && !strings.Contains(content, tt.want) {
+				t.Errorf("Expected generated config to contain %q\n%s", tt.want, content)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigGitLab(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "platform", Name: "tool", Host: "gitlab.acme.io", Forge: "gitlab"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{
+			Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "platform/homebrew-tap"},
+		},
+	}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"gitlab_urls:\n  api: https://gitlab.acme.io/api/v4/\n  download: https://gitlab.acme.io\n",
+		`token: "{{ .Env.GITLAB_TOKEN }}"`,
+		"homepage: https://gitlab.acme.io/platform/tool",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "GITHUB_TOKEN") {
+		t.Errorf("GitLab config must not need a GitHub token\n%s", content)
+	}
+	if issues, err := goreleaser.Validate([]byte(content)); err != nil || len(goreleaser.Errors(issues)) > 0 {
+		t.Errorf("Generated config has schema errors: %v %v", err, issues)
+	}
+
+	project.Repository.Host = "gitlab.com"
+	content, _ = GenerateGoReleaserConfig(project, config)
+	if strings.Contains(content, "gitlab_urls") {
+		t.Error("gitlab.com needs no gitlab_urls")
+	}
+
+	config.Config.Distributions.Winget = &models.WingetConfig{Enabled: true, PackageIdentifier: "Acme.Tool", Publisher: "Acme",
+		License: "MIT", ShortDescription: "A tool", ForkRepo: "acme/winget-pkgs"}
+	if _, err := GenerateGoReleaserConfig(project, config); err == nil {
+		t.Error("expected winget to be rejected for a GitLab project")
+	}
+}
+
+func TestGenerateGoReleaserConfigForgejo(t *testing.T) {
+	forge.SetAccounts([]models.ForgeAccount{{Kind: "forgejo", Host: "code.acme.io", APIURL: "https://code.acme.io/forgejo/api/v1"}})
+	defer forge.SetAccounts(nil)
+
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "platform", Name: "tool", Host: "code.acme.io", Forge: "forgejo"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	want := "gitea_urls:\n  api: https://code.acme.io/forgejo/api/v1/\n  download: https://code.acme.io\n"
+	if !strings.Contains(content, want) {
+		t.Errorf("Expected generated config to contain %q\n%s", want, content)
+	}
+	if strings.Contains(content, "gitlab_urls") || strings.Contains(content, "GITHUB_TOKEN") {
+		t.Errorf("Forgejo config must only point at the Forgejo instance\n%s", content)
+	}
+	if issues, err := goreleaser.Validate([]byte(content)); err != nil || len(goreleaser.Errors(issues)) > 0 {
+		t.Errorf("Generated config has schema errors: %v %v", err, issues)
+	}
+	if ForgeTokenEnv(project) != "GITEA_TOKEN" {
+		t.Errorf("ForgeTokenEnv = %s, want GITEA_TOKEN", ForgeTokenEnv(project))
+	}
+}
+
+func TestGenerateGoReleaserConfigGitHubEnterprise(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "infra", Name: "tool", Host: "ghe.acme.io", Forge: "github"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{
+			Homebrew: &models.HomebrewConfig{Enabled: true, TapRepo: "infra/homebrew-tap"},
+		},
+	}}
+
+	content, err := GenerateGoReleaserConfig(project, config)
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"github_urls:\n  api: https://ghe.acme.io/api/v3/\n  upload: https://ghe.acme.io/api/uploads/\n  download: https://ghe.acme.io/\n",
+		`token: "{{ .Env.GITHUB_TOKEN }}"`,
+		"homepage: https://ghe.acme.io/infra/tool",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+	if issues, err := goreleaser.Validate([]byte(content)); err != nil || len(goreleaser.Errors(issues)) > 0 {
+		t.Errorf("Generated config has schema errors: %v %v", err, issues)
+	}
+
+	project.Repository.Host = ""
+	project.Repository.Forge = ""
+	content, _ = GenerateGoReleaserConfig(project, config)
+	if strings.Contains(content, "github_urls") {
+		t.Error("github.com needs no github_urls")
+	}
+
+	project.Repository.Host = "ghe.acme.io"
+	config.Config.Distributions.Winget = &models.WingetConfig{Enabled: true, PackageIdentifier: "Acme.Tool", Publisher: "Acme",
+		License: "MIT", ShortDescription: "A tool", ForkRepo: "acme/winget-pkgs"}
+	if _, err := GenerateGoReleaserConfig(project, config); err == nil {
+		t.Error("expected winget to be rejected for a GitHub Enterprise project")
+	}
+}
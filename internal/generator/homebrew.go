@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// HomebrewPackages are the ways a tap can ship the binary, casks install the
+// prebuilt archive and are what GoReleaser v2 generates
+var HomebrewPackages = []string{"cask", "formula"}
+
+// TapMigrationsFile tells brew where a retired formula went
+const TapMigrationsFile = "tap_migrations.json"
+
+// DefaultHomebrewConfig publishes a cask with the detected license and the repo description
+func DefaultHomebrewConfig(project *models.ProjectInfo, description string) *models.HomebrewConfig {
+	if description == "" {
+		description = ProjectName(project)
+	}
+	config := &models.HomebrewConfig{Package: "cask", Description: description}
+	if project != nil {
+		config.License = project.License
+	}
+	return config
+}
+
+// HomebrewIsCask reports whether the tap gets a cask. Configs saved without a package
+// predate casks and keep their formula.
+func HomebrewIsCask(config *models.HomebrewConfig) bool {
+	return config != nil && config.Package == "cask"
+}
+
+// BrewName is the cask or formula name in the tap
+func BrewName(project *models.ProjectInfo) string {
+	if project != nil && project.Binary != nil && project.Binary.Name != "" {
+		return project.Binary.Name
+	}
+	if project != nil && project.Repository != nil {
+		return project.Repository.Name
+	}
+	return ""
+}
+
+// ValidateHomebrewConfig checks the tap and package type
+func ValidateHomebrewConfig(config *models.HomebrewConfig) error {
+	if config == nil {
+		return fmt.Errorf("homebrew settings missing")
+	}
+	tapParts := strings.Split(config.TapRepo, "/")
+	if len(tapParts) != 2 || tapParts[0] == "" || tapParts[1] == "" {
+		return fmt.Errorf("invalid homebrew tap repo format: expected 'owner/repo', got '%s'", config.TapRepo)
+	}
+	if config.Package != "" && config.Package != "cask" && config.Package != "formula" {
+		return fmt.Errorf("homebrew package must be cask or formula, got %q", config.Package)
+	}
+	return nil
+}
+
+// TapName is how users tap a repo, owner/homebrew-tap becomes owner/tap
+func TapName(tapRepo string) string {
+	owner, repo, _ := strings.Cut(tapRepo, "/")
+	return owner + "/" + strings.TrimPrefix(repo, "homebrew-")
+}
+
+// CaskQuarantineHook clears the quarantine flag macOS sets on downloads, the
+// binaries aren't notarized and Gatekeeper would refuse to run them
+func CaskQuarantineHook(binary string) []string {
+	return []string{
+		"if OS.mac?",
+		fmt.Sprintf(`  system_command "/usr/bin/xattr", args: ["-dr", "com.apple.quarantine", "#{staged_path}/%s"]`, binary),
+		"end",
+	}
+}
+
+func writeHomebrewSection(b *strings.Builder, project *models.ProjectInfo, config *models.HomebrewConfig, completions *models.CompletionSettings) error {
+	if err := ValidateHomebrewConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for homebrew distribution")
+	}
+
+	tapOwner, tapName, _ := strings.Cut(config.TapRepo, "/")
+	brewName := BrewName(project)
+	description := config.Description
+	if description == "" {
+		description = brewName
+	}
+
+	if HomebrewIsCask(config) {
+		b.WriteString("homebrew_casks:\n")
+	} else {
+		b.WriteString("brews:\n")
+	}
+	b.WriteString("  - name: " + brewName + "\n")
+	b.WriteString("    repository:\n")
+	b.WriteString(fmt.Sprintf("      owner: %s\n", tapOwner))
+	b.WriteString(fmt.Sprintf("      name: %s\n", tapName))
+	b.WriteString("      token: " + TokenTemplate(project) + "\n")
+	b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+	b.WriteString(fmt.Sprintf("    description: %q\n", description))
+	if config.License != "" {
+		b.WriteString(fmt.Sprintf("    license: %q\n", config.License))
+	}
+	b.WriteString("    commit_author:\n")
+	b.WriteString("      name: distui\n")
+	b.WriteString("      email: distui@users.noreply.github.com\n")
+
+	if !HomebrewIsCask(config) {
+		b.WriteString("    directory: Formula\n")
+		b.WriteString("    commit_msg_template: \"Brew formula update for " + brewName + " version {{ .Tag }}\"\n")
+		if completions != nil {
+			b.WriteString("    install: |\n")
+			for _, line := range BrewInstallLines(brewName, completions) {
+				b.WriteString("      " + line + "\n")
+			}
+		}
+		b.WriteString("    test: |\n")
+		b.WriteString("      system \"#{bin}/" + brewName + "\", \"--version\"\n\n")
+		return nil
+	}
+
+	b.WriteString("    directory: Casks\n")
+	b.WriteString("    commit_msg_template: \"Brew cask update for " + brewName + " version {{ .Tag }}\"\n")
+	b.WriteString("    binaries:\n")
+	b.WriteString("      - " + brewName + "\n")
+	if completions != nil && completions.Enabled {
+		b.WriteString("    completions:\n")
+		b.WriteString(fmt.Sprintf("      bash: %s/%s.bash\n", CompletionsDir, brewName))
+		b.WriteString(fmt.Sprintf("      zsh: %s/%s.zsh\n", CompletionsDir, brewName))
+		b.WriteString(fmt.Sprintf("      fish: %s/%s.fish\n", CompletionsDir, brewName))
+	}
+	if completions != nil && completions.ManPages {
+		b.WriteString("    manpages:\n")
+		b.WriteString(fmt.Sprintf("      - %s/%s.1.gz\n", ManPagesDir, brewName))
+	}
+	b.WriteString("    hooks:\n")
+	b.WriteString("      post:\n")
+	b.WriteString("        install: |\n")
+	for _, line := range CaskQuarantineHook(brewName) {
+		b.WriteString("          " + line + "\n")
+	}
+	b.WriteString("\n")
+	return nil
+}
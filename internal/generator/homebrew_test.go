@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestGenerateGoReleaserConfigHomebrewCask(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+
+	tests := []struct {
+		name     string
+		homebrew *models.HomebrewConfig
+		want     []string
+		notWant  []string
+	}{
+		{"cask with license", &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "cask",
+			Description: `Ships "tools"`, License: "Apache-2.0"},
+			[]string{
+				"homebrew_casks:\n  - name: tool",
+				"    directory: Casks",
+				`    description: "Ships \"tools\""`,
+				`    license: "Apache-2.0"`,
+				"    binaries:\n      - tool",
+				"      bash: completions/tool.bash",
+				`#{staged_path}/tool`,
+			},
+			[]string{"brews:", "bin.install"}},
+		{"unknown license is left out", &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "cask"},
+			[]string{`    description: "tool"`},
+			[]string{"license:"}},
+		{"saved without package stays a formula", &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap"},
+			[]string{"brews:\n  - name: tool", "    directory: Formula"},
+			[]string{"homebrew_casks:"}},
+		{"formula", &models.HomebrewConfig{Enabled: true, TapRepo: "acme/homebrew-tap", Package: "formula", License: "MIT"},
+			[]string{"brews:\n  - name: tool", "    directory: Formula", `    license: "MIT"`, `bin.install "tool"`},
+			[]string{"homebrew_casks:", "com.apple.quarantine"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &models.ProjectConfig{Config: &models.ProjectSettings{
+				Distributions: models.Distributions{Homebrew: tt.homebrew},
+				Completions:   &models.CompletionSettings{Enabled: true},
+			}}
+			content, err := GenerateGoReleaserConfig(project, config)
+			if err != nil {
+				t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(content, want) {
+					t.Errorf("Expected %q in\n%s", want, content)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(content, notWant) {
+					t.Errorf("Did not expect %q in\n%s", notWant, content)
+				}
+			}
+		})
+	}
+}
+
+func TestTapName(t *testing.T) {
+	if got := TapName("acme/homebrew-tap"); got != "acme/tap" {
+		t.Errorf("TapName = %q, want acme/tap", got)
+	}
+	if got := TapName("acme/brews"); got != "acme/brews" {
+		t.Errorf("TapName = %q, want acme/brews", got)
+	}
+}
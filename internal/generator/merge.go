@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManagedSections are the top-level GoReleaser keys distui owns when merging
+// into a hand-edited config. Everything else is left untouched.
+var ManagedSections = []string{"builds", "brews", "homebrew_casks", "scoops", "winget", "aurs", "nfpms", "dockers", "docker_manifests", "nix", "release", "changelog", "gitlab_urls", "gitea_urls", "github_urls"}
+
+// FindGoReleaserConfig returns the path of an existing goreleaser config, or "" if none.
+func FindGoReleaserConfig(projectPath string) string {
+	for _, name := range []string{".goreleaser.yaml", ".goreleaser.yml", "goreleaser.yaml", "goreleaser.yml"} {
+		path := filepath.Join(projectPath, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// MergeGoReleaserConfig replaces the managed sections of an existing config with
+// the ones from generated. The rest of the file is kept byte for byte, so comments,
+// formatting and unmanaged keys survive. A managed section missing from generated
+// is removed from the result, except release, which distui only writes for drafts
+// and prereleases.
+func MergeGoReleaserConfig(existing []byte, generated string) (string, error) {
+	var gen yaml.Node
+	if err := yaml.Unmarshal([]byte(generated), &gen); err != nil {
+		return "", fmt.Errorf("parsing generated config: %w", err)
+	}
+	genRoot := documentMapping(&gen)
+	if genRoot == nil {
+		return "", fmt.Errorf("generated config is not a YAML mapping")
+	}
+
+	result := string(existing)
+	for _, section := range ManagedSections {
+		var replacement string
+		if genValue := mappingValue(genRoot, section); genValue != nil {
+			rendered, err := renderSection(section, genValue)
+			if err != nil {
+				return "", err
+			}
+			replacement = rendered
+		} else if section == "release" {
+			continue
+		}
+
+		var err error
+		result, err = spliceSection(result, section, replacement)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Make sure we still produce a valid config
+	var check yaml.Node
+	if err := yaml.Unmarshal([]byte(result), &check); err != nil {
+		return "", fmt.Errorf("merged config is invalid: %w", err)
+	}
+
+	return result, nil
+}
+
+// spliceSection replaces the lines of a top-level section with replacement.
+// An empty replacement removes the section, a missing section is appended.
+func spliceSection(content, section, replacement string) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return "", fmt.Errorf("parsing existing config: %w", err)
+	}
+	root := documentMapping(&doc)
+	if root == nil {
+		return "", fmt.Errorf("existing config is not a YAML mapping")
+	}
+
+	lines := strings.Split(content, "\n")
+	idx := mappingIndex(root, section)
+
+	if idx < 0 {
+		if replacement == "" {
+			return content, nil
+		}
+		trimmed := strings.TrimRight(content, "\n")
+		if trimmed == "" {
+			return replacement + "\n", nil
+		}
+		return trimmed + "\n\n" + replacement + "\n", nil
+	}
+
+	start := root.Content[idx].Line - 1
+	end := len(lines)
+	if idx+2 < len(root.Content) {
+		end = root.Content[idx+2].Line - 1
+	}
+	// Leave blank lines and top-level comments that belong to the next key
+	for end > start+1 {
+		prev := lines[end-1]
+		if strings.TrimSpace(prev) == "" || strings.HasPrefix(prev, "#") {
+			end--
+			continue
+		}
+		break
+	}
+
+	var out []string
+	out = append(out, lines[:start]...)
+	if replacement != "" {
+		out = append(out, strings.Split(replacement, "\n")...)
+	} else {
+		// Drop the blank line separating the removed section
+		for end < len(lines) && strings.TrimSpace(lines[end]) == "" && end+1 < len(lines) {
+			end++
+		}
+	}
+	out = append(out, lines[end:]...)
+
+	return strings.Join(out, "\n"), nil
+}
+
+// renderSection encodes a single top-level key and its value
+func renderSection(key string, value *yaml.Node) (string, error) {
+	clearComments(value)
+	mapping := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			value,
+		},
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(mapping); err != nil {
+		return "", fmt.Errorf("encoding %s section: %w", key, err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("encoding %s section: %w", key, err)
+	}
+
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
+
+// MergeGoReleaserConfigFile reads the project's existing goreleaser config and
+// returns its path, current content and the merged result without writing anything.
+func MergeGoReleaserConfigFile(projectPath string, generated string) (string, string, string, error) {
+	path := FindGoReleaserConfig(projectPath)
+	if path == "" {
+		return "", "", "", fmt.Errorf("no goreleaser config found in %s", projectPath)
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", "", fmt.Errorf("reading goreleaser config: %w", err)
+	}
+
+	merged, err := MergeGoReleaserConfig(existing, generated)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return path, string(existing), merged, nil
+}
+
+func documentMapping(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	return doc.Content[0]
+}
+
+func mappingIndex(m *yaml.Node, key string) int {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	idx := mappingIndex(m, key)
+	if idx < 0 {
+		return nil
+	}
+	return m.Content[idx+1]
+}
+
+// clearComments drops comments from generated nodes so trailing notes from
+// the generator don't get attached to the merged sections.
+func clearComments(n *yaml.Node) {
+	n.HeadComment = ""
+	n.LineComment = ""
+	n.FootComment = ""
+	for _, child := range n.Content {
+		clearComments(child)
+	}
+}
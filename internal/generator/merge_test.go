@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const customConfig = `# Project release config
+version: 2
+
+project_name: tool
+
+builds:
+  - goos: [linux]
+
+# Custom archive layout, keep this
+archives:
+  - name_template: "{{ .ProjectName }}_{{ .Os }}"
+    files:
+      - README.md
+
+brews:
+  - name: old-formula
+
+signs:
+  - artifacts: checksum
+`
+
+const generatedConfig = `version: 2
+
+builds:
+  - env:
+      - CGO_ENABLED=0
+    goos:
+      - linux
+      - darwin
+
+release:
+  draft: false
+
+changelog:
+  disable: true
+
+archives:
+  - files:
+      - none*
+# NPM publishing requires package.json in repo
+`
+
+func TestMergeGoReleaserConfig(t *testing.T) {
+	merged, err := MergeGoReleaserConfig([]byte(customConfig), generatedConfig)
+	if err != nil {
+		t.Fatalf("MergeGoReleaserConfig failed: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := yaml.Unmarshal([]byte(merged), &result); err != nil {
+		t.Fatalf("Merged config is not valid YAML: %v\n%s", err, merged)
+	}
+
+	// Managed sections come from the generated config
+	builds := result["builds"].([]interface{})
+	goos := builds[0].(map[string]interface{})["goos"].([]interface{})
+	if len(goos) != 2 {
+		t.Errorf("Expected builds to be replaced, got goos %v", goos)
+	}
+	if _, ok := result["release"]; !ok {
+		t.Error("Expected release section to be added")
+	}
+	if _, ok := result["changelog"]; !ok {
+		t.Error("Expected changelog section to be added")
+	}
+	if _, ok := result["brews"]; ok {
+		t.Error("Expected brews to be removed when not generated")
+	}
+
+	// A hand-written release section survives when distui has nothing to set
+	withRelease := customConfig + "\nrelease:\n  name_template: \"{{ .Tag }}\"\n"
+	kept, err := MergeGoReleaserConfig([]byte(withRelease), strings.Replace(generatedConfig, "release:\n  draft: false\n\n", "", 1))
+	if err != nil {
+		t.Fatalf("MergeGoReleaserConfig failed: %v", err)
+	}
+	if !strings.Contains(kept, "name_template: \"{{ .Tag }}\"") {
+		t.Errorf("Expected release section to be kept:\n%s", kept)
+	}
+
+	// Unmanaged sections and comments are kept as-is
+	archives := result["archives"].([]interface{})
+	if archives[0].(map[string]interface{})["name_template"] != "{{ .ProjectName }}_{{ .Os }}" {
+		t.Errorf("Custom archives section was modified:\n%s", merged)
+	}
+	if _, ok := result["signs"]; !ok {
+		t.Error("Unknown key signs was dropped")
+	}
+	for _, comment := range []string{"# Project release config", "# Custom archive layout, keep this"} {
+		if !strings.Contains(merged, comment) {
+			t.Errorf("Comment %q was lost:\n%s", comment, merged)
+		}
+	}
+	if strings.Contains(merged, "NPM publishing") {
+		t.Errorf("Generator comments leaked into merged config:\n%s", merged)
+	}
+
+	// Existing key order and spacing is preserved
+	if strings.Index(merged, "builds:") > strings.Index(merged, "archives:") {
+		t.Errorf("Key order changed:\n%s", merged)
+	}
+	if !strings.Contains(merged, "version: 2\n\nproject_name: tool\n") {
+		t.Errorf("Formatting of unmanaged keys changed:\n%s", merged)
+	}
+
+	// Merging again is a no-op
+	again, err := MergeGoReleaserConfig([]byte(merged), generatedConfig)
+	if err != nil {
+		t.Fatalf("Second merge failed: %v", err)
+	}
+	if again != merged {
+		t.Errorf("Merge is not idempotent:\n%s\n---\n%s", merged, again)
+	}
+}
+
+func TestMergeGoReleaserConfigInvalid(t *testing.T) {
+	if _, err := MergeGoReleaserConfig([]byte("- just\n- a list\n"), generatedConfig); err == nil {
+		t.Error("Expected error for non-mapping config")
+	}
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// NFPMFormats are the Linux package formats offered, in display order
+var NFPMFormats = []string{"deb", "rpm", "apk", "archlinux"}
+
+// SystemdUnitDir is where packaged units go, it works for deb, rpm and arch alike
+const SystemdUnitDir = "/usr/lib/systemd/system"
+
+var systemdUnitSuffixes = []string{".service", ".socket", ".timer", ".path", ".target", ".mount"}
+
+// DefaultNFPMConfig starts with deb packages, the one everybody asks for
+func DefaultNFPMConfig(project *models.ProjectInfo, maintainer string) *models.NFPMConfig {
+	config := &models.NFPMConfig{
+		Formats:    []string{"deb"},
+		Maintainer: maintainer,
+		License:    ProjectLicense(project),
+	}
+	if project != nil && project.Repository != nil {
+		config.Vendor = project.Repository.Owner
+	}
+	return config
+}
+
+// NFPMPackageName is the package name, the binary name in lowercase
+func NFPMPackageName(project *models.ProjectInfo) string {
+	return strings.ToLower(ProjectName(project))
+}
+
+// SystemdUnitDst returns where a unit file from the repo is installed
+func SystemdUnitDst(unit string) string {
+	return SystemdUnitDir + "/" + path.Base(unit)
+}
+
+// ValidateNFPMConfig checks the settings before GoReleaser or dpkg get to complain
+func ValidateNFPMConfig(config *models.NFPMConfig) error {
+	if config == nil {
+		return fmt.Errorf("linux package settings missing")
+	}
+	if len(config.Formats) == 0 {
+		return fmt.Errorf("select at least one linux package format")
+	}
+	for _, format := range config.Formats {
+		if !containsString(NFPMFormats, format) {
+			return fmt.Errorf("unknown linux package format %q, expected one of %s", format, strings.Join(NFPMFormats, ", "))
+		}
+	}
+	// deb and rpm both require a maintainer, dpkg wants the email too
+	if !maintainerRe.MatchString(config.Maintainer) {
+		return fmt.Errorf("package maintainer %q must look like 'Name <email>'", config.Maintainer)
+	}
+	if strings.TrimSpace(config.License) == "" {
+		return fmt.Errorf("package license is required")
+	}
+	for _, file := range config.ConfigFiles {
+		if file.Src == "" || !strings.HasPrefix(file.Dst, "/") {
+			return fmt.Errorf("config file %q needs a source and an absolute destination", file.Src+":"+file.Dst)
+		}
+	}
+	for _, unit := range config.SystemdUnits {
+		if !hasAnySuffix(unit, systemdUnitSuffixes) {
+			return fmt.Errorf("%s is not a systemd unit (%s)", unit, strings.Join(systemdUnitSuffixes, ", "))
+		}
+	}
+	return nil
+}
+
+// NFPMScriptList returns the configured scripts keyed by the GoReleaser field name
+func NFPMScriptList(scripts models.NFPMScripts) [][2]string {
+	var list [][2]string
+	for _, script := range [][2]string{
+		{"preinstall", scripts.PreInstall},
+		{"postinstall", scripts.PostInstall},
+		{"preremove", scripts.PreRemove},
+		{"postremove", scripts.PostRemove},
+	} {
+		if script[1] != "" {
+			list = append(list, script)
+		}
+	}
+	return list
+}
+
+func writeNFPMSection(b *strings.Builder, project *models.ProjectInfo, config *models.NFPMConfig, completions *models.CompletionSettings) error {
+	if err := ValidateNFPMConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for linux packages")
+	}
+
+	binary := ProjectName(project)
+
+	b.WriteString("nfpms:\n")
+	b.WriteString("  - package_name: " + NFPMPackageName(project) + "\n")
+	if config.Vendor != "" {
+		b.WriteString(fmt.Sprintf("    vendor: %q\n", config.Vendor))
+	}
+	b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+	b.WriteString(fmt.Sprintf("    maintainer: %q\n", config.Maintainer))
+	b.WriteString("    description: \"" + binary + "\"\n")
+	b.WriteString(fmt.Sprintf("    license: %q\n", config.License))
+	b.WriteString("    bindir: /usr/bin\n")
+	b.WriteString("    formats:\n")
+	for _, format := range config.Formats {
+		b.WriteString("      - " + format + "\n")
+	}
+	if len(config.Dependencies) > 0 {
+		b.WriteString("    dependencies:\n")
+		for _, dep := range config.Dependencies {
+			b.WriteString(fmt.Sprintf("      - %q\n", dep))
+		}
+	}
+
+	contents := NFPMContents(binary, config, completions)
+	if len(contents) > 0 {
+		b.WriteString("    contents:\n")
+		for _, content := range contents {
+			b.WriteString(fmt.Sprintf("      - src: %q\n", content[0]))
+			b.WriteString(fmt.Sprintf("        dst: %q\n", content[1]))
+			if content[2] != "" {
+				b.WriteString(fmt.Sprintf("        type: %q\n", content[2]))
+			}
+		}
+	}
+
+	if scripts := NFPMScriptList(config.Scripts); len(scripts) > 0 {
+		b.WriteString("    scripts:\n")
+		for _, script := range scripts {
+			b.WriteString(fmt.Sprintf("      %s: %q\n", script[0], script[1]))
+		}
+	}
+	b.WriteString("\n")
+	return nil
+}
+
+// NFPMContents returns src, dst and type of every extra file in the packages
+func NFPMContents(binary string, config *models.NFPMConfig, completions *models.CompletionSettings) [][3]string {
+	var contents [][3]string
+	for _, file := range config.ConfigFiles {
+		contents = append(contents, [3]string{file.Src, file.Dst, "config|noreplace"})
+	}
+	for _, unit := range config.SystemdUnits {
+		contents = append(contents, [3]string{unit, SystemdUnitDst(unit), ""})
+	}
+	if completions != nil && completions.Enabled {
+		contents = append(contents,
+			[3]string{fmt.Sprintf("%s/%s.bash", CompletionsDir, binary), "/usr/share/bash-completion/completions/" + binary, ""},
+			[3]string{fmt.Sprintf("%s/%s.zsh", CompletionsDir, binary), "/usr/share/zsh/site-functions/_" + binary, ""},
+			[3]string{fmt.Sprintf("%s/%s.fish", CompletionsDir, binary), "/usr/share/fish/vendor_completions.d/" + binary + ".fish", ""},
+		)
+	}
+	if completions != nil && completions.ManPages {
+		contents = append(contents, [3]string{fmt.Sprintf("%s/%s.1.gz", ManPagesDir, binary), "/usr/share/man/man1/" + binary + ".1.gz", ""})
+	}
+	return contents
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnySuffix(value string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestValidateNFPMConfig(t *testing.T) {
+	valid := models.NFPMConfig{Formats: []string{"deb"}, Maintainer: "Jane Doe <jane@example.com>", License: "MIT"}
+
+	tests := []struct {
+		name    string
+		modify  func(c *models.NFPMConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *models.NFPMConfig) {}, false},
+		{"no formats", func(c *models.NFPMConfig) { c.Formats = nil }, true},
+		{"unknown format", func(c *models.NFPMConfig) { c.Formats = []string{"deb", "msi"} }, true},
+		{"maintainer without email", func(c *models.NFPMConfig) { c.Maintainer = "Jane Doe" }, true},
+		{"relative config destination", func(c *models.NFPMConfig) {
+			c.ConfigFiles = []models.NFPMContent{{Src: "config.yaml", Dst: "etc/tool.yaml"}}
+		}, true},
+		{"not a unit", func(c *models.NFPMConfig) { c.SystemdUnits = []string{"packaging/tool.conf"} }, true},
+		{"timer unit", func(c *models.NFPMConfig) { c.SystemdUnits = []string{"packaging/tool.timer"} }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateNFPMConfig(&config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNFPMConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigNFPM(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "Tool"},
+		License:    "MIT",
+	}
+	nfpm := DefaultNFPMConfig(project, Maintainer("Jane Doe", "jane@example.com"))
+	nfpm.Enabled = true
+	nfpm.Formats = []string{"deb", "rpm"}
+	nfpm.Dependencies = []string{"git"}
+	nfpm.ConfigFiles = []models.NFPMContent{{Src: "config.yaml", Dst: "/etc/tool/config.yaml"}}
+	nfpm.SystemdUnits = []string{"packaging/tool.service"}
+	nfpm.Scripts.PreRemove = "packaging/preremove.sh"
+
+	content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{NFPM: nfpm},
+		Completions:   &models.CompletionSettings{Enabled: true},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"nfpms:\n  - package_name: tool\n",
+		`    vendor: "acme"`,
+		`    maintainer: "Jane Doe <jane@example.com>"`,
+		"    formats:\n      - deb\n      - rpm\n",
+		"    dependencies:\n      - \"git\"\n",
+		"      - src: \"config.yaml\"\n        dst: \"/etc/tool/config.yaml\"\n        type: \"config|noreplace\"\n",
+		"      - src: \"packaging/tool.service\"\n        dst: \"/usr/lib/systemd/system/tool.service\"\n",
+		`        dst: "/usr/share/zsh/site-functions/_Tool"`,
+		"    scripts:\n      preremove: \"packaging/preremove.sh\"\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// NixModes are the ways a Nix package is published
+var NixModes = []string{"nur", "flake"}
+
+// FlakeFile is the standalone flake generated in flake mode
+const FlakeFile = "flake.nix"
+
+// nixpkgs license attributes, e.g. mit, asl20, gpl3Only
+var nixLicenseRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+var flakeVendorHashRe = regexp.MustCompile(`(?m)^\s*vendorHash = (null|"([^"]*)");`)
+
+var goMajorSuffixRe = regexp.MustCompile(`^v[0-9]+$`)
+
+// nixLicenses maps SPDX identifiers to nixpkgs license attributes
+var nixLicenses = map[string]string{
+	"MIT":           "mit",
+	"Apache-2.0":    "asl20",
+	"BSD-2-Clause":  "bsd2",
+	"BSD-3-Clause":  "bsd3",
+	"0BSD":          "bsd0",
+	"ISC":           "isc",
+	"MPL-2.0":       "mpl20",
+	"GPL-2.0-only":  "gpl2Only",
+	"GPL-3.0-only":  "gpl3Only",
+	"LGPL-2.1-only": "lgpl21Only",
+	"LGPL-3.0-only": "lgpl3Only",
+	"AGPL-3.0-only": "agpl3Only",
+	"Unlicense":     "unlicense",
+}
+
+// NixLicense returns the nixpkgs attribute for an SPDX identifier, "" if unknown
+func NixLicense(spdx string) string {
+	return nixLicenses[spdx]
+}
+
+// DefaultNixConfig publishes to the owner's nur-packages repository
+func DefaultNixConfig(project *models.ProjectInfo) *models.NixConfig {
+	name := ProjectName(project)
+	config := &models.NixConfig{
+		Mode:        "nur",
+		Path:        "pkgs/" + name + "/default.nix",
+		License:     NixLicense(ProjectLicense(project)),
+		Description: name,
+	}
+	if project != nil && project.Repository != nil && project.Repository.Owner != "" {
+		config.Repository = project.Repository.Owner + "/nur-packages"
+	}
+	return config
+}
+
+// ValidateNixConfig checks the settings of the selected mode
+func ValidateNixConfig(config *models.NixConfig) error {
+	if config == nil {
+		return fmt.Errorf("nix settings missing")
+	}
+	if !containsString(NixModes, config.Mode) {
+		return fmt.Errorf("nix mode must be nur or flake, got %q", config.Mode)
+	}
+	if strings.TrimSpace(config.License) == "" {
+		return fmt.Errorf("nix license is required")
+	}
+	if !nixLicenseRe.MatchString(config.License) {
+		return fmt.Errorf("nix license %q must be a nixpkgs license attribute like mit or asl20", config.License)
+	}
+	if strings.TrimSpace(config.Description) == "" {
+		return fmt.Errorf("nix description is required")
+	}
+	if config.Mode == "flake" {
+		return nil
+	}
+
+	parts := strings.Split(config.Repository, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid NUR repository format: expected 'owner/repo', got '%s'", config.Repository)
+	}
+	if !strings.HasSuffix(config.Path, ".nix") || strings.HasPrefix(config.Path, "/") {
+		return fmt.Errorf("nix path %q must be a relative .nix file, e.g. pkgs/tool/default.nix", config.Path)
+	}
+	return nil
+}
+
+// NixCompletionInstall returns the shell lines that install completions and man pages
+// from the archive, installShellFiles provides both commands
+func NixCompletionInstall(binary string, settings *models.CompletionSettings) []string {
+	if settings == nil {
+		return nil
+	}
+	var lines []string
+	if settings.Enabled {
+		lines = append(lines, fmt.Sprintf("installShellCompletion --cmd %[2]s --bash ./%[1]s/%[2]s.bash --zsh ./%[1]s/%[2]s.zsh --fish ./%[1]s/%[2]s.fish", CompletionsDir, binary))
+	}
+	if settings.ManPages {
+		lines = append(lines, fmt.Sprintf("installManPage ./%s/%s.1.gz", ManPagesDir, binary))
+	}
+	return lines
+}
+
+func writeNixSection(b *strings.Builder, project *models.ProjectInfo, config *models.NixConfig, completions *models.CompletionSettings) error {
+	if err := ValidateNixConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for nix distribution")
+	}
+
+	repo := strings.Split(config.Repository, "/")
+
+	b.WriteString("nix:\n")
+	b.WriteString("  - name: " + ProjectName(project) + "\n")
+	b.WriteString("    repository:\n")
+	b.WriteString(fmt.Sprintf("      owner: %s\n", repo[0]))
+	b.WriteString(fmt.Sprintf("      name: %s\n", repo[1]))
+	b.WriteString("      token: " + TokenTemplate(project) + "\n")
+	b.WriteString(fmt.Sprintf("    path: %s\n", config.Path))
+	b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+	b.WriteString(fmt.Sprintf("    description: %q\n", config.Description))
+	b.WriteString(fmt.Sprintf("    license: %q\n", config.License))
+	if lines := NixCompletionInstall(ProjectName(project), completions); len(lines) > 0 {
+		b.WriteString("    extra_install: |-\n")
+		for _, line := range lines {
+			b.WriteString("      " + line + "\n")
+		}
+	}
+	b.WriteString("    commit_author:\n")
+	b.WriteString("      name: distui\n")
+	b.WriteString("      email: distui@users.noreply.github.com\n")
+	b.WriteString("    commit_msg_template: \"{{ .ProjectName }}: {{ .Tag }}\"\n\n")
+	return nil
+}
+
+// GenerateFlake returns a flake.nix building the project from source with buildGoModule.
+// vendorHash comes from gomod.VendorHash, empty means the module has no dependencies.
+func GenerateFlake(project *models.ProjectInfo, config *models.NixConfig, vendorHash string, completions *models.CompletionSettings) (string, error) {
+	if err := ValidateNixConfig(config); err != nil {
+		return "", err
+	}
+	binary := ProjectName(project)
+	if binary == "" {
+		return "", fmt.Errorf("project name required for flake.nix")
+	}
+
+	hash := "null"
+	if vendorHash != "" {
+		hash = fmt.Sprintf("%q", vendorHash)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Generated by distui\n")
+	b.WriteString("# Regenerate release files after changing dependencies, vendorHash covers go.sum\n")
+	b.WriteString("{\n")
+	b.WriteString(fmt.Sprintf("  description = %q;\n\n", config.Description))
+	b.WriteString("  inputs.nixpkgs.url = \"github:NixOS/nixpkgs/nixos-unstable\";\n\n")
+	b.WriteString("  outputs = { self, nixpkgs }:\n")
+	b.WriteString("    let\n")
+	b.WriteString("      systems = [ \"x86_64-linux\" \"aarch64-linux\" \"x86_64-darwin\" \"aarch64-darwin\" ];\n")
+	b.WriteString("      forAllSystems = f: nixpkgs.lib.genAttrs systems (system: f nixpkgs.legacyPackages.${system});\n")
+	b.WriteString("    in\n")
+	b.WriteString("    {\n")
+	b.WriteString("      packages = forAllSystems (pkgs: {\n")
+	b.WriteString("        default = pkgs.buildGoModule {\n")
+	b.WriteString(fmt.Sprintf("          pname = %q;\n", binary))
+	b.WriteString("          version = self.shortRev or self.dirtyShortRev or \"dev\";\n")
+	b.WriteString("          src = self;\n")
+	b.WriteString(fmt.Sprintf("          vendorHash = %s;\n", hash))
+	b.WriteString("          subPackages = [ \".\" ];\n")
+	b.WriteString("          ldflags = [ \"-s\" \"-w\" ];\n")
+
+	var install []string
+	// go install names the binary after the module path, GoReleaser uses the binary name
+	if project.Module != nil && project.Module.Name != "" {
+		if installed := goInstallName(project.Module.Name); installed != binary {
+			install = append(install, fmt.Sprintf("mv $out/bin/%s $out/bin/%s", installed, binary))
+		}
+	}
+	var generated []string
+	if completions != nil && completions.Enabled {
+		generated = append(generated,
+			fmt.Sprintf("installShellCompletion --cmd %[1]s \\", binary),
+			fmt.Sprintf("  --bash <($out/bin/%[1]s completion bash) \\", binary),
+			fmt.Sprintf("  --zsh <($out/bin/%[1]s completion zsh) \\", binary),
+			fmt.Sprintf("  --fish <($out/bin/%[1]s completion fish)", binary),
+		)
+	}
+	if completions != nil && completions.ManPages {
+		generated = append(generated, fmt.Sprintf("$out/bin/%[1]s man > %[1]s.1", binary), fmt.Sprintf("installManPage %s.1", binary))
+	}
+
+	if len(generated) > 0 {
+		b.WriteString("          nativeBuildInputs = [ pkgs.installShellFiles ];\n")
+	}
+	// Completions come from running the binary, not possible when cross compiling
+	canExecute := "pkgs.lib.optionalString (pkgs.stdenv.buildPlatform.canExecute pkgs.stdenv.hostPlatform) ''\n"
+	switch {
+	case len(install) > 0:
+		b.WriteString("          postInstall = ''\n")
+		for _, line := range install {
+			b.WriteString("            " + line + "\n")
+		}
+		if len(generated) > 0 {
+			b.WriteString("          '' + " + canExecute)
+		}
+	case len(generated) > 0:
+		b.WriteString("          postInstall = " + canExecute)
+	}
+	for _, line := range generated {
+		b.WriteString("            " + line + "\n")
+	}
+	if len(install) > 0 || len(generated) > 0 {
+		b.WriteString("          '';\n")
+	}
+
+	b.WriteString("          meta = {\n")
+	b.WriteString(fmt.Sprintf("            description = %q;\n", config.Description))
+	if project.Repository != nil {
+		b.WriteString(fmt.Sprintf("            homepage = \"%s\";\n", ProjectURL(project)))
+	}
+	b.WriteString(fmt.Sprintf("            license = pkgs.lib.licenses.%s;\n", config.License))
+	b.WriteString(fmt.Sprintf("            mainProgram = %q;\n", binary))
+	b.WriteString("          };\n")
+	b.WriteString("        };\n")
+	b.WriteString("      });\n")
+	b.WriteString("    };\n")
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// FlakeVendorHash returns the vendorHash of a flake.nix, "" for null, false if there is none
+func FlakeVendorHash(content string) (string, bool) {
+	match := flakeVendorHashRe.FindStringSubmatch(content)
+	if match == nil {
+		return "", false
+	}
+	return match[2], true
+}
+
+// goInstallName is the binary name go install uses for a main package at the module root
+func goInstallName(modulePath string) string {
+	parts := strings.Split(modulePath, "/")
+	if len(parts) > 1 && goMajorSuffixRe.MatchString(parts[len(parts)-1]) {
+		return parts[len(parts)-2]
+	}
+	return path.Base(modulePath)
+}
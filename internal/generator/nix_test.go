@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestValidateNixConfig(t *testing.T) {
+	valid := models.NixConfig{Mode: "nur", Repository: "acme/nur-packages", Path: "pkgs/tool/default.nix", License: "mit", Description: "A tool"}
+
+	tests := []struct {
+		name    string
+		modify  func(c *models.NixConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *models.NixConfig) {}, false},
+		{"unknown mode", func(c *models.NixConfig) { c.Mode = "channel" }, true},
+		{"spdx license", func(c *models.NixConfig) { c.License = "Apache-2.0" }, true},
+		{"no license", func(c *models.NixConfig) { c.License = "" }, true},
+		{"missing repository", func(c *models.NixConfig) { c.Repository = "" }, true},
+		{"absolute path", func(c *models.NixConfig) { c.Path = "/pkgs/tool.nix" }, true},
+		{"flake ignores repository", func(c *models.NixConfig) { c.Mode = "flake"; c.Repository = ""; c.Path = "" }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateNixConfig(&config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNixConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateGoReleaserConfigNix(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+		License:    "MIT",
+	}
+	nix := DefaultNixConfig(project)
+	nix.Enabled = true
+
+	content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{Nix: nix},
+		Completions:   &models.CompletionSettings{Enabled: true},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"nix:\n  - name: tool\n    repository:\n      owner: acme\n      name: nur-packages\n",
+		"    path: pkgs/tool/default.nix\n",
+		`    license: "mit"`,
+		"      installShellCompletion --cmd tool --bash ./completions/tool.bash",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+
+	nix.Mode = "flake"
+	content, err = GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{Nix: nix},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	if strings.Contains(content, "nix:") {
+		t.Errorf("flake mode should not generate a nix section\n%s", content)
+	}
+}
+
+func TestGenerateFlake(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Module:     &models.ModuleInfo{Name: "github.com/acme/tool-cli/v2"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.NixConfig{Mode: "flake", License: "asl20", Description: "A tool"}
+
+	tests := []struct {
+		name       string
+		vendorHash string
+		want       []string
+	}{
+		{"no dependencies", "", []string{"vendorHash = null;", "mv $out/bin/tool-cli $out/bin/tool"}},
+		{"dependencies", "sha256-abc=", []string{`vendorHash = "sha256-abc=";`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := GenerateFlake(project, config, tt.vendorHash, &models.CompletionSettings{Enabled: true})
+			if err != nil {
+				t.Fatalf("GenerateFlake failed: %v", err)
+			}
+			want := append(tt.want,
+				"# Generated by distui\n",
+				"default = pkgs.buildGoModule {",
+				`pname = "tool";`,
+				"nativeBuildInputs = [ pkgs.installShellFiles ];",
+				"--bash <($out/bin/tool completion bash)",
+				"license = pkgs.lib.licenses.asl20;",
+				`mainProgram = "tool";`,
+			)
+			for _, w := range want {
+				if !strings.Contains(content, w) {
+					t.Errorf("Expected flake to contain %q\n%s", w, content)
+				}
+			}
+
+			hash, ok := FlakeVendorHash(content)
+			if !ok || hash != tt.vendorHash {
+				t.Errorf("FlakeVendorHash() = %q, %v, want %q", hash, ok, tt.vendorHash)
+			}
+			if strings.Count(content, "{") != strings.Count(content, "}") {
+				t.Errorf("Unbalanced braces in flake\n%s", content)
+			}
+		})
+	}
+}
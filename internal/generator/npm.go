@@ -0,0 +1,311 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"distui/internal/models"
+)
+
+// NPMModes are the ways an npm package can deliver the binary
+var NPMModes = []string{"postinstall", "native"}
+
+// NPMPublishModes decide when package.json gets the release version
+var NPMPublishModes = []string{"commit", "pretag", "staged"}
+
+const (
+	NPMPublishCommit = "commit" // Bump, commit and push to the branch after the tag
+	NPMPublishPreTag = "pretag" // Bump and commit before tagging, the tag carries the version
+	NPMPublishStaged = "staged" // Publish a versioned copy, package.json in the repo is left alone
+)
+
+// NPMPublishMode returns the configured publish mode, commit when unset
+func NPMPublishMode(config *models.NPMConfig) string {
+	if config == nil || config.Publish == "" {
+		return NPMPublishCommit
+	}
+	return config.Publish
+}
+
+// DefaultNPMRegistry is where npm publishes when no registry is configured
+const DefaultNPMRegistry = "https://registry.npmjs.org/"
+
+var (
+	npmTagRe    = regexp.MustCompile(`^[a-z][a-z0-9._-]*$`)
+	tokenEnvRe  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	semverishRe = regexp.MustCompile(`^v?\d`)
+)
+
+// NPMRegistries is the configured registry followed by the extra ones, each published to in turn
+func NPMRegistries(config *models.NPMConfig) []models.NPMRegistry {
+	primary := models.NPMRegistry{URL: DefaultNPMRegistry}
+	if config == nil {
+		return []models.NPMRegistry{primary}
+	}
+	if config.Registry != "" {
+		primary.URL = config.Registry
+	}
+	primary.TokenEnv = config.TokenEnv
+	return append([]models.NPMRegistry{primary}, config.ExtraRegistries...)
+}
+
+// ParseNPMRegistries reads extra registries written as url or url=TOKEN_ENV
+func ParseNPMRegistries(values []string) []models.NPMRegistry {
+	var registries []models.NPMRegistry
+	for _, value := range values {
+		url, env, _ := strings.Cut(value, "=")
+		registries = append(registries, models.NPMRegistry{URL: strings.TrimSpace(url), TokenEnv: strings.TrimSpace(env)})
+	}
+	return registries
+}
+
+// FormatNPMRegistries is the inverse of ParseNPMRegistries
+func FormatNPMRegistries(registries []models.NPMRegistry) string {
+	var values []string
+	for _, registry := range registries {
+		value := registry.URL
+		if registry.TokenEnv != "" {
+			value += "=" + registry.TokenEnv
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, ", ")
+}
+
+// NPMRegistryKey is the prefix .npmrc uses for the registry's auth settings, //host/path/
+func NPMRegistryKey(registry string) string {
+	key := registry
+	if i := strings.Index(key, "://"); i >= 0 {
+		key = key[i+1:]
+	}
+	return strings.TrimSuffix(key, "/") + "/"
+}
+
+// IsNPMJS reports whether registry is the public npm registry, the only one with provenance
+func IsNPMJS(registry string) bool {
+	return NPMRegistryKey(registry) == NPMRegistryKey(DefaultNPMRegistry)
+}
+
+// NPMPlatform is a build target and the os/cpu values npm uses for it
+type NPMPlatform struct {
+	GOOS   string
+	GOARCH string
+	OS     string
+	CPU    string
+}
+
+// NPMPlatforms matches the builds section of the generated goreleaser config
+var NPMPlatforms = []NPMPlatform{
+	{GOOS: "linux", GOARCH: "amd64", OS: "linux", CPU: "x64"},
+	{GOOS: "linux", GOARCH: "arm64", OS: "linux", CPU: "arm64"},
+	{GOOS: "darwin", GOARCH: "amd64", OS: "darwin", CPU: "x64"},
+	{GOOS: "darwin", GOARCH: "arm64", OS: "darwin", CPU: "arm64"},
+	{GOOS: "windows", GOARCH: "amd64", OS: "win32", CPU: "x64"},
+	{GOOS: "windows", GOARCH: "arm64", OS: "win32", CPU: "arm64"},
+}
+
+// NPMIsNative reports whether npm ships per-platform packages instead of the postinstall download
+func NPMIsNative(config *models.NPMConfig) bool {
+	return config != nil && config.Mode == "native"
+}
+
+// ValidateNPMConfig checks the npm settings before they are saved
+func ValidateNPMConfig(config *models.NPMConfig) error {
+	if config.PackageName == "" {
+		return fmt.Errorf("package name is required")
+	}
+	if config.PackageName != strings.ToLower(config.PackageName) || strings.ContainsAny(config.PackageName, " ~()'!*") {
+		return fmt.Errorf("package name %q must be lowercase without spaces or ~()'!*", config.PackageName)
+	}
+	if config.Mode != "" && config.Mode != "postinstall" && config.Mode != "native" {
+		return fmt.Errorf("mode must be postinstall or native")
+	}
+	if config.Publish != "" && config.Publish != NPMPublishCommit && config.Publish != NPMPublishPreTag && config.Publish != NPMPublishStaged {
+		return fmt.Errorf("publish must be commit, pretag or staged")
+	}
+	switch config.Access {
+	case "", "public":
+	case "restricted":
+		if !strings.HasPrefix(config.PackageName, "@") {
+			return fmt.Errorf("restricted access needs a scoped package name (@scope/name)")
+		}
+	default:
+		return fmt.Errorf("access must be public or restricted")
+	}
+	// npm refuses tags that look like versions, they would shadow version ranges
+	if config.Tag != "" && (!npmTagRe.MatchString(config.Tag) || semverishRe.MatchString(config.Tag)) {
+		return fmt.Errorf("dist-tag %q must be a lowercase name like latest, next or beta", config.Tag)
+	}
+	for _, registry := range NPMRegistries(config) {
+		if !strings.HasPrefix(registry.URL, "https://") && !strings.HasPrefix(registry.URL, "http://") {
+			return fmt.Errorf("registry %q must be an http(s) URL", registry.URL)
+		}
+		if registry.TokenEnv != "" && !tokenEnvRe.MatchString(registry.TokenEnv) {
+			return fmt.Errorf("token variable %q is not a valid environment variable name", registry.TokenEnv)
+		}
+	}
+	return nil
+}
+
+// NPMPackageName is the configured package name, falling back to the binary or module name
+func NPMPackageName(project *models.ProjectInfo, config *models.ProjectConfig) string {
+	if config != nil && config.Config != nil && config.Config.Distributions.NPM != nil &&
+		config.Config.Distributions.NPM.PackageName != "" {
+		return config.Config.Distributions.NPM.PackageName
+	}
+	return npmBinaryName(project)
+}
+
+func npmBinaryName(project *models.ProjectInfo) string {
+	if project.Binary != nil && project.Binary.Name != "" {
+		return project.Binary.Name
+	}
+	if project.Module != nil {
+		return project.Module.Name
+	}
+	return ""
+}
+
+// NPMPlatformPackage names the package holding the binary for platform, @scope/tool becomes @scope/tool-linux-x64
+func NPMPlatformPackage(mainPackage string, platform NPMPlatform) string {
+	return fmt.Sprintf("%s-%s-%s", mainPackage, platform.OS, platform.CPU)
+}
+
+// NPMBinaryFile is the path of the binary inside a platform package
+func NPMBinaryFile(binary string, platform NPMPlatform) string {
+	if platform.GOOS == "windows" {
+		return "bin/" + binary + ".exe"
+	}
+	return "bin/" + binary
+}
+
+// GenerateNativePackageJSON writes the main package of the native mode: the JS shim as
+// bin and every platform package as an optional dependency, npm installs the matching one
+func GenerateNativePackageJSON(project *models.ProjectInfo, config *models.ProjectConfig) (string, error) {
+	packageName := NPMPackageName(project, config)
+	binary := npmBinaryName(project)
+	version := npmVersion(project)
+
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	sb.WriteString("  \"_comment\": \"Generated by distui\",\n")
+	sb.WriteString(fmt.Sprintf("  \"name\": %q,\n", packageName))
+	sb.WriteString(fmt.Sprintf("  \"version\": %q,\n", version))
+	sb.WriteString(fmt.Sprintf("  \"description\": %q,\n", fmt.Sprintf("%s - distributed via distui", project.Module.Name)))
+	sb.WriteString("  \"bin\": {\n")
+	sb.WriteString(fmt.Sprintf("    %q: %q\n", binary, "bin/"+binary+".js"))
+	sb.WriteString("  },\n")
+	sb.WriteString("  \"files\": [\n")
+	sb.WriteString("    \"bin\"\n")
+	sb.WriteString("  ],\n")
+	if repo := npmRepositoryURL(project); repo != "" {
+		sb.WriteString("  \"repository\": {\n")
+		sb.WriteString("    \"type\": \"git\",\n")
+		sb.WriteString(fmt.Sprintf("    \"url\": %q\n", repo))
+		sb.WriteString("  },\n")
+	}
+	sb.WriteString("  \"keywords\": [\n")
+	sb.WriteString("    \"cli\",\n")
+	sb.WriteString("    \"tool\"\n")
+	sb.WriteString("  ],\n")
+	if license := ProjectLicense(project); license != "" {
+		sb.WriteString(fmt.Sprintf("  \"license\": %q,\n", license))
+	}
+	sb.WriteString("  \"optionalDependencies\": {\n")
+	for i, platform := range NPMPlatforms {
+		sb.WriteString(fmt.Sprintf("    %q: %q", NPMPlatformPackage(packageName, platform), version))
+		if i < len(NPMPlatforms)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("  }\n")
+	sb.WriteString("}\n")
+	return sb.String(), nil
+}
+
+// GeneratePlatformPackageJSON writes the package.json of the package holding one platform's binary
+func GeneratePlatformPackageJSON(project *models.ProjectInfo, mainPackage, version string, platform NPMPlatform) string {
+	binary := npmBinaryName(project)
+
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	sb.WriteString(fmt.Sprintf("  \"name\": %q,\n", NPMPlatformPackage(mainPackage, platform)))
+	sb.WriteString(fmt.Sprintf("  \"version\": %q,\n", strings.TrimPrefix(version, "v")))
+	sb.WriteString(fmt.Sprintf("  \"description\": %q,\n", fmt.Sprintf("The %s %s-%s binary for %s", binary, platform.OS, platform.CPU, mainPackage)))
+	if repo := npmRepositoryURL(project); repo != "" {
+		sb.WriteString("  \"repository\": {\n")
+		sb.WriteString("    \"type\": \"git\",\n")
+		sb.WriteString(fmt.Sprintf("    \"url\": %q\n", repo))
+		sb.WriteString("  },\n")
+	}
+	if license := ProjectLicense(project); license != "" {
+		sb.WriteString(fmt.Sprintf("  \"license\": %q,\n", license))
+	}
+	sb.WriteString("  \"files\": [\n")
+	sb.WriteString(fmt.Sprintf("    %q\n", NPMBinaryFile(binary, platform)))
+	sb.WriteString("  ],\n")
+	sb.WriteString(fmt.Sprintf("  \"os\": [%q],\n", platform.OS))
+	sb.WriteString(fmt.Sprintf("  \"cpu\": [%q]\n", platform.CPU))
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// NPMShim is bin/<binary>.js of the main package. It finds the platform package npm
+// installed and runs its binary with the same arguments, stdio and exit code.
+func NPMShim(project *models.ProjectInfo, mainPackage string) string {
+	binary := npmBinaryName(project)
+
+	var sb strings.Builder
+	sb.WriteString("#!/usr/bin/env node\n")
+	sb.WriteString("// Generated by distui\n")
+	sb.WriteString("\"use strict\";\n\n")
+	sb.WriteString("const { spawnSync } = require(\"child_process\");\n\n")
+	sb.WriteString("const packages = {\n")
+	for _, platform := range NPMPlatforms {
+		sb.WriteString(fmt.Sprintf("  %q: %q,\n", platform.OS+"-"+platform.CPU, NPMPlatformPackage(mainPackage, platform)))
+	}
+	sb.WriteString("};\n\n")
+	sb.WriteString("const platform = `${process.platform}-${process.arch}`;\n")
+	sb.WriteString("const pkg = packages[platform];\n")
+	sb.WriteString("if (!pkg) {\n")
+	sb.WriteString(fmt.Sprintf("  console.error(`%s: no prebuilt binary for ${platform}`);\n", binary))
+	sb.WriteString("  process.exit(1);\n")
+	sb.WriteString("}\n\n")
+	sb.WriteString("let binary;\n")
+	sb.WriteString("try {\n")
+	sb.WriteString(fmt.Sprintf("  binary = require.resolve(`${pkg}/bin/%s${process.platform === \"win32\" ? \".exe\" : \"\"}`);\n", binary))
+	sb.WriteString("} catch (err) {\n")
+	sb.WriteString(fmt.Sprintf("  console.error(`%s: ${pkg} is not installed, reinstall without --omit=optional or --no-optional`);\n", binary))
+	sb.WriteString("  process.exit(1);\n")
+	sb.WriteString("}\n\n")
+	sb.WriteString("const result = spawnSync(binary, process.argv.slice(2), { stdio: \"inherit\" });\n")
+	sb.WriteString("if (result.error) {\n")
+	sb.WriteString(fmt.Sprintf("  console.error(`%s: ${result.error.message}`);\n", binary))
+	sb.WriteString("  process.exit(1);\n")
+	sb.WriteString("}\n")
+	sb.WriteString("if (result.signal) {\n")
+	sb.WriteString("  process.kill(process.pid, result.signal);\n")
+	sb.WriteString("}\n")
+	sb.WriteString("process.exit(result.status === null ? 1 : result.status);\n")
+	return sb.String()
+}
+
+func npmVersion(project *models.ProjectInfo) string {
+	version := ""
+	if project.Module != nil {
+		version = project.Module.Version
+	}
+	if version == "" {
+		version = "0.0.1"
+	}
+	return strings.TrimPrefix(version, "v")
+}
+
+func npmRepositoryURL(project *models.ProjectInfo) string {
+	if project.Repository == nil {
+		return ""
+	}
+	return ProjectURL(project) + ".git"
+}
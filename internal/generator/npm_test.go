@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestGenerateNativePackageJSON(t *testing.T) {
+	project := &models.ProjectInfo{
+		Module:     &models.ModuleInfo{Name: "tool", Version: "v1.2.0"},
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool-repo"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	config := &models.ProjectConfig{Config: &models.ProjectSettings{}}
+	config.Config.Distributions.NPM = &models.NPMConfig{Enabled: true, PackageName: "@acme/tool", Mode: "native"}
+
+	content, err := GeneratePackageJSON(project, config)
+	if err != nil {
+		t.Fatalf("GeneratePackageJSON failed: %v", err)
+	}
+
+	var pkg struct {
+		Name                 string            `json:"name"`
+		Version              string            `json:"version"`
+		Bin                  map[string]string `json:"bin"`
+		Scripts              map[string]string `json:"scripts"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		t.Fatalf("package.json is not valid JSON: %v\n%s", err, content)
+	}
+	if pkg.Name != "@acme/tool" || pkg.Version != "1.2.0" {
+		t.Errorf("name/version = %s@%s", pkg.Name, pkg.Version)
+	}
+	if pkg.Bin["tool"] != "bin/tool.js" {
+		t.Errorf("bin = %v, want the shim", pkg.Bin)
+	}
+	if len(pkg.Scripts) != 0 {
+		t.Errorf("native packages must not need install scripts, got %v", pkg.Scripts)
+	}
+	if len(pkg.OptionalDependencies) != len(NPMPlatforms) || pkg.OptionalDependencies["@acme/tool-win32-x64"] != "1.2.0" {
+		t.Errorf("optionalDependencies = %v", pkg.OptionalDependencies)
+	}
+
+	shim := NPMShim(project, "@acme/tool")
+	for _, want := range []string{`"darwin-arm64": "@acme/tool-darwin-arm64"`, "bin/tool${", "spawnSync"} {
+		if !strings.Contains(shim, want) {
+			t.Errorf("shim lacks %q", want)
+		}
+	}
+}
+
+func TestGeneratePlatformPackageJSON(t *testing.T) {
+	project := &models.ProjectInfo{Binary: &models.BinaryInfo{Name: "tool"}}
+	platform := NPMPlatform{GOOS: "windows", GOARCH: "arm64", OS: "win32", CPU: "arm64"}
+
+	var pkg struct {
+		Name    string   `json:"name"`
+		Version string   `json:"version"`
+		Files   []string `json:"files"`
+		OS      []string `json:"os"`
+		CPU     []string `json:"cpu"`
+	}
+	content := GeneratePlatformPackageJSON(project, "tool", "v2.0.0", platform)
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		t.Fatalf("package.json is not valid JSON: %v\n%s", err, content)
+	}
+	if pkg.Name != "tool-win32-arm64" || pkg.Version != "2.0.0" {
+		t.Errorf("name/version = %s@%s", pkg.Name, pkg.Version)
+	}
+	if len(pkg.Files) != 1 || pkg.Files[0] != "bin/tool.exe" {
+		t.Errorf("files = %v", pkg.Files)
+	}
+	if len(pkg.OS) != 1 || pkg.OS[0] != "win32" || len(pkg.CPU) != 1 || pkg.CPU[0] != "arm64" {
+		t.Errorf("os/cpu = %v/%v", pkg.OS, pkg.CPU)
+	}
+}
+
+func TestNPMRegistries(t *testing.T) {
+	config := &models.NPMConfig{
+		Registry:        "https://npm.example.com/team",
+		TokenEnv:        "TEAM_TOKEN",
+		ExtraRegistries: ParseNPMRegistries([]string{"https://registry.npmjs.org/=NPM_TOKEN"}),
+	}
+	registries := NPMRegistries(config)
+	if len(registries) != 2 || registries[0].URL != config.Registry || registries[0].TokenEnv != "TEAM_TOKEN" || registries[1].TokenEnv != "NPM_TOKEN" {
+		t.Fatalf("registries = %+v", registries)
+	}
+	if got := NPMRegistryKey(registries[0].URL); got != "//npm.example.com/team/" {
+		t.Errorf("registry key = %s", got)
+	}
+	if !IsNPMJS(registries[1].URL) || IsNPMJS(registries[0].URL) {
+		t.Error("only the second registry is npmjs")
+	}
+	if got := FormatNPMRegistries(config.ExtraRegistries); got != "https://registry.npmjs.org/=NPM_TOKEN" {
+		t.Errorf("formatted = %s", got)
+	}
+	if got := NPMRegistries(nil); len(got) != 1 || got[0].URL != DefaultNPMRegistry {
+		t.Errorf("default registries = %+v", got)
+	}
+}
+
+func TestValidateNPMConfigRegistryOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  models.NPMConfig
+		wantErr bool
+	}{
+		{"defaults", models.NPMConfig{PackageName: "tool"}, false},
+		{"restricted scoped", models.NPMConfig{PackageName: "@acme/tool", Access: "restricted"}, false},
+		{"restricted unscoped", models.NPMConfig{PackageName: "tool", Access: "restricted"}, true},
+		{"unknown access", models.NPMConfig{PackageName: "tool", Access: "private"}, true},
+		{"beta tag", models.NPMConfig{PackageName: "tool", Tag: "beta"}, false},
+		{"version tag", models.NPMConfig{PackageName: "tool", Tag: "v1.2.0"}, true},
+		{"registry without scheme", models.NPMConfig{PackageName: "tool", Registry: "npm.example.com"}, true},
+		{"bad token env", models.NPMConfig{PackageName: "tool", TokenEnv: "NPM-TOKEN"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNPMConfig(&tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNPMConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -44,6 +44,10 @@ func GeneratePackageJSON(project *models.ProjectInfo, config *models.ProjectConf
 		return "", fmt.Errorf("npm config not found")
 	}
 
+	if NPMIsNative(config.Config.Distributions.NPM) {
+		return GenerateNativePackageJSON(project, config)
+	}
+
 	packageName := config.Config.Distributions.NPM.PackageName
 	if packageName == "" {
 		packageName = project.Binary.Name
@@ -79,21 +83,24 @@ func GeneratePackageJSON(project *models.ProjectInfo, config *models.ProjectConf
 			"golang-npm": "^0.0.6",
 		},
 		Keywords: []string{"cli", "tool"},
-		License:  "MIT",
+		License:  ProjectLicense(project),
 	}
 
 	if project.Repository != nil {
 		pkg.Repository = &Repository{
 			Type: "git",
-			URL:  fmt.Sprintf("https://github.com/%s/%s.git", project.Repository.Owner, project.Repository.Name),
+			URL:  ProjectURL(project) + ".git",
 		}
 
-		// Add goBinary configuration for golang-npm
+		// Add goBinary configuration for golang-npm, following the archive name_template
+		url, err := NPMArchiveURL(project, ArchiveSettingsFor(config))
+		if err != nil {
+			return "", err
+		}
 		pkg.GoBinary = &GoBinary{
 			Name: binaryName,
 			Path: "./bin",
-			URL:  fmt.Sprintf("https://github.com/%s/%s/releases/download/v{{version}}/%s_{{version}}_{{platform}}_{{arch}}.tar.gz",
-				project.Repository.Owner, project.Repository.Name, binaryName),
+			URL:  url,
 		}
 	}
 
@@ -135,8 +142,10 @@ func GeneratePackageJSON(project *models.ProjectInfo, config *models.ProjectConf
 	}
 	sb.WriteString("  ],\n")
 
-	// license
-	sb.WriteString(fmt.Sprintf("  \"license\": \"%s\",\n", pkg.License))
+	// license, left out when no license file was found
+	if pkg.License != "" {
+		sb.WriteString(fmt.Sprintf("  \"license\": \"%s\",\n", pkg.License))
+	}
 
 	// dependencies
 	sb.WriteString("  \"dependencies\": {\n")
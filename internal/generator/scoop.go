@@ -0,0 +1,11 @@
+package generator
+
+import "distui/internal/models"
+
+// ScoopManifestName is the manifest file name in the bucket, without .json
+func ScoopManifestName(project *models.ProjectInfo, scoop *models.ScoopConfig) string {
+	if scoop != nil && scoop.ManifestName != "" {
+		return scoop.ManifestName
+	}
+	return ProjectName(project)
+}
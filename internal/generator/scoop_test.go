@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestGenerateGoReleaserConfigScoop(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+
+	tests := []struct {
+		name    string
+		scoop   *models.ScoopConfig
+		archive *models.ArchiveSettings
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			scoop: &models.ScoopConfig{Enabled: true, BucketRepo: "acme/scoop-bucket"},
+			want: []string{
+				"scoops:\n  - name: tool\n",
+				"      owner: acme\n      name: scoop-bucket\n",
+				"    directory: bucket\n",
+			},
+		},
+		{
+			name:  "manifest name",
+			scoop: &models.ScoopConfig{Enabled: true, BucketRepo: "acme/bucket", ManifestName: "acme-tool"},
+			want:  []string{"  - name: acme-tool\n"},
+		},
+		{
+			name:    "bad bucket",
+			scoop:   &models.ScoopConfig{Enabled: true, BucketRepo: "scoop-bucket"},
+			wantErr: true,
+		},
+		{
+			name:    "tar.gz on windows",
+			scoop:   &models.ScoopConfig{Enabled: true, BucketRepo: "acme/scoop-bucket"},
+			archive: &models.ArchiveSettings{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &models.ProjectConfig{Config: &models.ProjectSettings{
+				Distributions: models.Distributions{Scoop: tt.scoop},
+				Archive:       tt.archive,
+			}}
+			content, err := GenerateGoReleaserConfig(project, config)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got config:\n%s", content)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(content, want) {
+					t.Errorf("Expected generated config to contain %q\n%s", want, content)
+				}
+			}
+		})
+	}
+}
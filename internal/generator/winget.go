@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"distui/internal/forge"
+	"distui/internal/models"
+)
+
+// WingetBaseRepo is where manifest pull requests are opened
+const WingetBaseRepo = "microsoft/winget-pkgs"
+
+// Same rules winget-pkgs validates: 2 to 8 dot separated segments of up to 32 characters
+var wingetIdentifierRe = regexp.MustCompile(`^[^.\s\\/:*?"<>|\x00-\x1f]{1,32}(\.[^.\s\\/:*?"<>|\x00-\x1f]{1,32}){1,7}$`)
+
+var wingetSegmentRe = regexp.MustCompile(`[^A-Za-z0-9-]+`)
+
+// ValidateWingetIdentifier checks the Publisher.Package form winget-pkgs requires
+func ValidateWingetIdentifier(id string) error {
+	if id == "" {
+		return fmt.Errorf("winget package identifier is required")
+	}
+	if !wingetIdentifierRe.MatchString(id) {
+		return fmt.Errorf("winget package identifier %q must look like Publisher.Package", id)
+	}
+	return nil
+}
+
+// DefaultWingetConfig derives publisher, identifier and fork from the repository
+func DefaultWingetConfig(project *models.ProjectInfo) *models.WingetConfig {
+	config := &models.WingetConfig{License: ProjectLicense(project), ShortDescription: ProjectName(project)}
+	if project == nil || project.Repository == nil || project.Repository.Owner == "" {
+		return config
+	}
+
+	owner := project.Repository.Owner
+	config.Publisher = owner
+	config.PackageIdentifier = wingetSegment(owner) + "." + wingetSegment(ProjectName(project))
+	config.ForkRepo = owner + "/winget-pkgs"
+	return config
+}
+
+func wingetSegment(name string) string {
+	segment := strings.Trim(wingetSegmentRe.ReplaceAllString(name, "-"), "-")
+	if len(segment) > 32 {
+		segment = segment[:32]
+	}
+	if segment == "" {
+		return segment
+	}
+	return strings.ToUpper(segment[:1]) + segment[1:]
+}
+
+// ValidateWingetConfig reports the metadata winget-pkgs would reject the manifest without
+func ValidateWingetConfig(config *models.WingetConfig) error {
+	if config == nil {
+		return fmt.Errorf("winget settings missing")
+	}
+	if err := ValidateWingetIdentifier(config.PackageIdentifier); err != nil {
+		return err
+	}
+	if strings.TrimSpace(config.Publisher) == "" {
+		return fmt.Errorf("winget publisher is required")
+	}
+	if strings.TrimSpace(config.License) == "" {
+		return fmt.Errorf("winget license is required")
+	}
+	if strings.TrimSpace(config.ShortDescription) == "" {
+		return fmt.Errorf("winget short description is required")
+	}
+	parts := strings.Split(config.ForkRepo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid winget fork repo format: expected 'owner/repo', got '%s'", config.ForkRepo)
+	}
+	return nil
+}
+
+func writeWingetSection(b *strings.Builder, project *models.ProjectInfo, config *models.WingetConfig) error {
+	if err := ValidateWingetConfig(config); err != nil {
+		return err
+	}
+	if project.Repository == nil {
+		return fmt.Errorf("repository information required for winget distribution")
+	}
+	// GoReleaser opens the PR through the release's client, only GitHub reaches winget-pkgs
+	if ProjectForge(project) != forge.GitHub || ProjectHost(project) != forge.DefaultGitHubHost {
+		return fmt.Errorf("winget needs the project on GitHub, its PRs go to %s", WingetBaseRepo)
+	}
+
+	fork := strings.Split(config.ForkRepo, "/")
+	base := strings.Split(WingetBaseRepo, "/")
+
+	b.WriteString("winget:\n")
+	b.WriteString("  - name: " + ProjectName(project) + "\n")
+	b.WriteString(fmt.Sprintf("    publisher: %q\n", config.Publisher))
+	b.WriteString(fmt.Sprintf("    package_identifier: %s\n", config.PackageIdentifier))
+	b.WriteString(fmt.Sprintf("    short_description: %q\n", config.ShortDescription))
+	b.WriteString(fmt.Sprintf("    license: %q\n", config.License))
+	b.WriteString("    homepage: " + ProjectURL(project) + "\n")
+	b.WriteString("    publisher_url: https://" + ProjectHost(project) + "/" + project.Repository.Owner + "\n")
+	b.WriteString("    commit_author:\n")
+	b.WriteString("      name: distui\n")
+	b.WriteString("      email: distui@users.noreply.github.com\n")
+	b.WriteString("    commit_msg_template: \"New version: {{ .PackageIdentifier }} {{ .Version }}\"\n")
+	b.WriteString("    repository:\n")
+	b.WriteString(fmt.Sprintf("      owner: %s\n", fork[0]))
+	b.WriteString(fmt.Sprintf("      name: %s\n", fork[1]))
+	b.WriteString("      branch: \"{{ .ProjectName }}-{{ .Version }}\"\n")
+	b.WriteString("      token: " + TokenTemplate(project) + "\n")
+	b.WriteString("      pull_request:\n")
+	b.WriteString("        enabled: true\n")
+	b.WriteString("        base:\n")
+	b.WriteString(fmt.Sprintf("          owner: %s\n", base[0]))
+	b.WriteString(fmt.Sprintf("          name: %s\n", base[1]))
+	b.WriteString("          branch: master\n\n")
+	return nil
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"distui/internal/models"
+)
+
+func TestValidateWingetIdentifier(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{"Acme.Tool", false},
+		{"Acme.Tools.CLI", false},
+		{"acme-inc.tool_2", false},
+		{"", true},
+		{"Tool", true},
+		{"Acme Tool.CLI", true},
+		{"Acme..Tool", true},
+		{"Acme/Tool.CLI", true},
+		{"A.B.C.D.E.F.G.H.I", true},
+		{strings.Repeat("a", 33) + ".Tool", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			err := ValidateWingetIdentifier(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWingetIdentifier(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultWingetConfig(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme-inc", Name: "my.tool"},
+		Binary:     &models.BinaryInfo{Name: "my.tool"},
+		License:    "Apache-2.0",
+	}
+
+	config := DefaultWingetConfig(project)
+	if config.PackageIdentifier != "Acme-inc.My-tool" {
+		t.Errorf("PackageIdentifier = %q", config.PackageIdentifier)
+	}
+	if config.ForkRepo != "acme-inc/winget-pkgs" {
+		t.Errorf("ForkRepo = %q", config.ForkRepo)
+	}
+	if err := ValidateWingetConfig(config); err != nil {
+		t.Errorf("Default config should be valid: %v", err)
+	}
+
+	// Without a license file nothing is assumed, the user has to enter one
+	project.License = ""
+	if err := ValidateWingetConfig(DefaultWingetConfig(project)); err == nil || !strings.Contains(err.Error(), "license") {
+		t.Errorf("Expected a missing license error, got %v", err)
+	}
+}
+
+func TestGenerateGoReleaserConfigWinget(t *testing.T) {
+	project := &models.ProjectInfo{
+		Repository: &models.RepositoryInfo{Owner: "acme", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+	}
+	winget := &models.WingetConfig{Enabled: true, PackageIdentifier: "Acme.Tool", Publisher: "Acme",
+		License: "MIT", ShortDescription: "A tool", ForkRepo: "octo/winget-pkgs"}
+
+	content, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{Winget: winget},
+	}})
+	if err != nil {
+		t.Fatalf("GenerateGoReleaserConfig failed: %v", err)
+	}
+	for _, want := range []string{
+		"    package_identifier: Acme.Tool\n",
+		"      owner: octo\n      name: winget-pkgs\n",
+		"          owner: microsoft\n          name: winget-pkgs\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected generated config to contain %q\n%s", want, content)
+		}
+	}
+
+	missing := *winget
+	missing.Publisher = ""
+	if _, err := GenerateGoReleaserConfig(project, &models.ProjectConfig{Config: &models.ProjectSettings{
+		Distributions: models.Distributions{Winget: &missing},
+	}}); err == nil {
+		t.Error("Expected an error for missing publisher")
+	}
+}
@@ -4,18 +4,19 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+
+	"distui/internal/forge"
+	"distui/internal/github"
 )
 
-// HasGitHubRemote checks if a GitHub remote exists
+// HasGitHubRemote checks if origin is on github.com or a GitHub Enterprise Server host
 func HasGitHubRemote() bool {
-	cmd := exec.Command("git", "remote", "get-url", "origin")
-	output, err := cmd.Output()
+	origin, err := forge.Origin("")
 	if err != nil {
 		return false
 	}
-
-	remoteURL := strings.TrimSpace(string(output))
-	return strings.Contains(remoteURL, "github.com")
+	remote, err := forge.ParseRemote(origin)
+	return err == nil && remote.Kind == forge.GitHub
 }
 
 // HasGitRepo checks if we're in a git repository
@@ -25,54 +26,38 @@ func HasGitRepo() bool {
 	return err == nil
 }
 
-// GetRepoInfo extracts owner and repo name from git remote
-func GetRepoInfo() (owner, repo string, err error) {
-	cmd := exec.Command("git", "remote", "get-url", "origin")
-	output, cmdErr := cmd.Output()
-	if cmdErr != nil {
-		return "", "", fmt.Errorf("no git remote found")
+// HasForgeRemote checks if origin is on a forge distui knows
+func HasForgeRemote() bool {
+	origin, err := forge.Origin("")
+	if err != nil {
+		return false
 	}
+	_, err = forge.ParseRemote(origin)
+	return err == nil
+}
 
-	remoteURL := strings.TrimSpace(string(output))
-
-	// Handle both SSH and HTTPS formats
-	// SSH: git@github.com:owner/repo.git
-	// HTTPS: https://github.com/owner/repo.git
-
-	if strings.HasPrefix(remoteURL, "git@github.com:") {
-		// SSH format
-		path := strings.TrimPrefix(remoteURL, "git@github.com:")
-		path = strings.TrimSuffix(path, ".git")
-		parts := strings.Split(path, "/")
-		if len(parts) == 2 {
-			return parts[0], parts[1], nil
-		}
-	} else if strings.Contains(remoteURL, "github.com/") {
-		// HTTPS format
-		parts := strings.Split(remoteURL, "github.com/")
-		if len(parts) == 2 {
-			path := strings.TrimSuffix(parts[1], ".git")
-			pathParts := strings.Split(path, "/")
-			if len(pathParts) == 2 {
-				return pathParts[0], pathParts[1], nil
-			}
-		}
+// GetRepoInfo extracts owner and repo name from git remote.
+// GitLab owners can be nested groups, group/subgroup.
+func GetRepoInfo() (owner, repo string, err error) {
+	origin, err := forge.Origin("")
+	if err != nil {
+		return "", "", err
 	}
-
-	return "", "", fmt.Errorf("could not parse GitHub remote URL: %s", remoteURL)
+	remote, err := forge.ParseRemote(origin)
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse remote URL: %w", err)
+	}
+	return remote.Owner, remote.Name, nil
 }
 
-// CheckGitHubRepoExists checks if the repo exists on GitHub
+// CheckGitHubRepoExists checks if the origin repo exists on its forge
 func CheckGitHubRepoExists() bool {
-	owner, repo, err := GetRepoInfo()
+	f, remote, err := forge.Detect("")
 	if err != nil {
 		return false
 	}
-
-	// Use gh CLI to check if repo exists
-	cmd := exec.Command("gh", "repo", "view", fmt.Sprintf("%s/%s", owner, repo))
-	err = cmd.Run()
-	return err == nil
+	exists, err := f.RepoExists(remote)
+	return err == nil && exists
 }
 
 // GetDefaultRepoName returns the current directory name as default repo name
@@ -84,8 +69,26 @@ func GetDefaultRepoName() string {
 	return strings.TrimSpace(string(output))
 }
 
-// CreateGitHubRepo creates a new GitHub repository
+// CreateGitHubRepo creates a new repository. The owner is a GitHub user or org, or
+// host/owner for other hosts, e.g. gitlab.example.com/platform or ghe.example.com/infra.
 func CreateGitHubRepo(isPrivate bool, customName string, description string, owner string) error {
+	f, owner := ForgeForOwner(owner)
+	return CreateRepo(f, isPrivate, customName, description, owner)
+}
+
+// ForgeForOwner splits a host/ prefix off owner and returns the forge it names, GitHub otherwise
+func ForgeForOwner(owner string) (forge.Forge, string) {
+	if host, rest, ok := strings.Cut(owner, "/"); ok && strings.Contains(host, ".") {
+		if kind, known := forge.KindForHost(host); known {
+			f, _ := forge.For(forge.Remote{Kind: kind, Host: host})
+			return f, rest
+		}
+	}
+	return forge.NewGitHub(""), owner
+}
+
+// CreateRepo creates the repository on f and points origin at it
+func CreateRepo(f forge.Forge, isPrivate bool, customName string, description string, owner string) error {
 	repoName := customName
 	if repoName == "" {
 		repoName = GetDefaultRepoName()
@@ -102,41 +105,19 @@ func CreateGitHubRepo(isPrivate bool, customName string, description string, own
 	// Ensure we're on main branch (not master)
 	exec.Command("git", "branch", "-M", "main").Run()
 
-	// Check if we already have a remote - if so, remove it
-	hasRemote := HasGitHubRemote()
-	if hasRemote {
-		exec.Command("git", "remote", "remove", "origin").Run()
-	}
-
-	// Create repo with gh CLI
-	var repoFullName string
-	if owner != "" {
-		repoFullName = fmt.Sprintf("%s/%s", owner, repoName)
-	} else {
-		repoFullName = repoName
-	}
-
-	args := []string{"repo", "create", repoFullName}
-	if isPrivate {
-		args = append(args, "--private")
-	} else {
-		args = append(args, "--public")
-	}
-
-	// Add description if provided
-	if description != "" {
-		args = append(args, "--description", description)
-	}
-
-	// Create the repo
-	cmd := exec.Command("gh", args...)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to create GitHub repo: %w", err)
+	remote, err := f.CreateRepo(forge.RepoOptions{
+		Owner:       owner,
+		Name:        repoName,
+		Description: description,
+		Private:     isPrivate,
+	})
+	if err != nil {
+		return err
 	}
 
-	// Add the remote manually (don't push yet - that's for release workflow)
-	remoteURL := fmt.Sprintf("git@github.com:%s.git", repoFullName)
-	cmd = exec.Command("git", "remote", "add", "origin", remoteURL)
+	// Replace any existing remote, don't push yet - that's for release workflow
+	exec.Command("git", "remote", "remove", "origin").Run()
+	cmd := exec.Command("git", "remote", "add", "origin", remote.SSHURL())
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to add remote: %w", err)
 	}
@@ -151,9 +132,8 @@ func IsGHCLIAvailable() bool {
 	return err == nil
 }
 
-// IsAuthenticated checks if gh CLI is authenticated
+// IsAuthenticated checks if a GitHub token can be found, from the environment or gh
 func IsAuthenticated() bool {
-	cmd := exec.Command("gh", "auth", "status")
-	err := cmd.Run()
+	_, err := github.Token("")
 	return err == nil
 }
\ No newline at end of file
@@ -6,6 +6,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"distui/internal/forge"
 )
 
 type RepoStatus string
@@ -57,15 +59,9 @@ func CheckRepoState() (*RepoInfo, error) {
 		info.RemoteURL = strings.TrimSpace(string(output))
 		info.RemoteExists = true
 
-		if strings.Contains(info.RemoteURL, "github.com") {
-			parts := strings.Split(info.RemoteURL, "/")
-			if len(parts) >= 2 {
-				info.RepoName = strings.TrimSuffix(parts[len(parts)-1], ".git")
-				info.Owner = parts[len(parts)-2]
-				if strings.Contains(info.Owner, ":") {
-					info.Owner = strings.Split(info.Owner, ":")[1]
-				}
-			}
+		if remote, err := forge.ParseRemote(info.RemoteURL); err == nil {
+			info.RepoName = remote.Name
+			info.Owner = remote.Owner
 		}
 	}
 
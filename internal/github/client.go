@@ -0,0 +1,175 @@
+// Package github is a small client for the GitHub REST API, github.com or GitHub
+// Enterprise Server, so distui doesn't need to shell out to gh for every call.
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultHost = "github.com"
+
+var (
+	// rateLimitWait is the longest distui waits for a rate limit to reset before giving up
+	rateLimitWait = time.Minute
+	sleep         = time.Sleep
+)
+
+// Client makes authenticated requests to the API of one host
+type Client struct {
+	host    string
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// New returns a client for host with a discovered token, see Token
+func New(host string) (*Client, error) {
+	token, err := Token(host)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithToken(host, token), nil
+}
+
+func NewWithToken(host, token string) *Client {
+	if host == "" {
+		host = DefaultHost
+	}
+	return &Client{
+		host:    host,
+		baseURL: APIURL(host),
+		token:   token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// APIURL is the REST API of github.com or of a GitHub Enterprise Server host
+func APIURL(host string) string {
+	if host == "" || host == DefaultHost {
+		return "https://api.github.com"
+	}
+	return "https://" + host + "/api/v3"
+}
+
+func (c *Client) Host() string { return c.host }
+
+func (c *Client) get(path string, out interface{}) error {
+	_, err := c.do("GET", path, nil, out)
+	return err
+}
+
+func (c *Client) post(path string, body, out interface{}) error {
+	_, err := c.do("POST", path, body, out)
+	return err
+}
+
+// do sends the request, waiting out a short rate limit once, and returns the next
+// page's URL from the Link header
+func (c *Client) do(method, path string, body, out interface{}) (string, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return "", fmt.Errorf("encoding request: %w", err)
+		}
+	}
+	url := path
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		url = c.baseURL + path
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(method, url, bytes.NewReader(data))
+		if err != nil {
+			return "", fmt.Errorf("building request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("%s %s: %w", method, c.host, err)
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return "", fmt.Errorf("reading response: %w", err)
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			if out != nil && len(respBody) > 0 {
+				if err := json.Unmarshal(respBody, out); err != nil {
+					return "", fmt.Errorf("parsing response: %w", err)
+				}
+			}
+			return nextPage(resp.Header.Get("Link")), nil
+		}
+
+		apiErr := newError(resp.StatusCode, respBody)
+		if limit := rateLimit(resp, apiErr); limit != nil {
+			wait := time.Until(limit.Reset)
+			if attempt == 0 && wait <= rateLimitWait {
+				sleep(max(wait, time.Second))
+				continue
+			}
+			return "", limit
+		}
+		return "", apiErr
+	}
+}
+
+// rateLimit recognises the primary limit (no requests remaining) and the secondary
+// one (Retry-After), both answered with 403 or 429
+func rateLimit(resp *http.Response, apiErr *Error) *RateLimitError {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return nil
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return &RateLimitError{Reset: time.Now().Add(time.Duration(seconds) * time.Second), Err: apiErr}
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		reset, _ := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		return &RateLimitError{Reset: time.Unix(reset, 0), Err: apiErr}
+	}
+	return nil
+}
+
+var nextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+func nextPage(link string) string {
+	if m := nextLink.FindStringSubmatch(link); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// getAll follows the pages of a list endpoint
+func getAll[T any](c *Client, path string) ([]T, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	var all []T
+	next := path + sep + "per_page=100"
+	for next != "" {
+		var page []T
+		var err error
+		if next, err = c.do("GET", next, nil, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+	}
+	return all, nil
+}
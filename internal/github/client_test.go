@@ -0,0 +1,165 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"Bad credentials"}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client := NewWithToken("github.com", "test-token")
+	client.baseURL = server.URL
+	client.http = server.Client()
+	return client
+}
+
+func TestToken(t *testing.T) {
+	dir := t.TempDir()
+	hosts := "github.com:\n    user: alice\n    oauth_token: gho_file\nghe.acme.io:\n    oauth_token: gho_enterprise\n"
+	if err := os.WriteFile(filepath.Join(dir, "hosts.yml"), []byte(hosts), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GH_CONFIG_DIR", dir)
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_ENTERPRISE_TOKEN", "")
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "")
+	t.Cleanup(func() { tokens = map[string]string{} })
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		host string
+		want string
+	}{
+		{"hosts file", nil, "", "gho_file"},
+		{"enterprise hosts file", nil, "ghe.acme.io", "gho_enterprise"},
+		{"environment first", map[string]string{"GITHUB_TOKEN": "ghp_env"}, "github.com", "ghp_env"},
+		{"enterprise environment", map[string]string{"GITHUB_TOKEN": "ghp_env", "GH_ENTERPRISE_TOKEN": "ghp_ent"}, "ghe.acme.io", "ghp_ent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			tokens = map[string]string{}
+			got, err := Token(tt.host)
+			if err != nil || got != tt.want {
+				t.Errorf("Token(%q) = %q, %v, want %q", tt.host, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+	_, err := client.Repo("acme", "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	client.token = "revoked"
+	_, err = client.User()
+	if !IsUnauthorized(err) || IsNotFound(err) {
+		t.Errorf("expected unauthorized, got %v", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	var slept time.Duration
+	sleep = func(d time.Duration) { slept += d }
+	defer func() { sleep = time.Sleep }()
+
+	calls := 0
+	reset := time.Now().Add(2 * time.Second)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+			return
+		}
+		fmt.Fprint(w, `{"login":"alice"}`)
+	})
+	user, err := client.User()
+	if err != nil || user.Login != "alice" {
+		t.Fatalf("User() = %+v, %v", user, err)
+	}
+	if calls != 2 || slept == 0 {
+		t.Errorf("expected one wait and a retry, calls=%d slept=%s", calls, slept)
+	}
+
+	client = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	_, err = client.User()
+	var limit *RateLimitError
+	if !errors.As(err, &limit) || time.Until(limit.Reset) < 30*time.Minute {
+		t.Errorf("expected a rate limit error an hour out, got %v", err)
+	}
+}
+
+func TestPullRequests(t *testing.T) {
+	var baseURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /repos/acme/tool/pulls":
+			var body NewPullRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Head == "feature" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"message":"A pull request already exists for acme:feature."}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"number": 7, "html_url": "https://github.com/acme/tool/pull/7"}`)
+		case "GET /repos/acme/tool/pulls":
+			if r.URL.Query().Get("head") != "acme:feature" || r.URL.Query().Get("base") != "main" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			if r.URL.Query().Get("page") == "" {
+				next := r.URL.Query()
+				next.Set("page", "2")
+				link := baseURL + r.URL.Path + "?" + next.Encode()
+				w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next", <%s>; rel="last"`, link, link))
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprint(w, `[{"number": 3, "html_url": "https://github.com/acme/tool/pull/3"}]`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+	baseURL = client.baseURL
+
+	pr, err := client.CreatePullRequest("acme", "tool", NewPullRequest{Title: "Fix", Head: "fix", Base: "main"})
+	if err != nil || pr.Number != 7 {
+		t.Fatalf("CreatePullRequest() = %+v, %v", pr, err)
+	}
+	pr, err = client.CreatePullRequest("acme", "tool", NewPullRequest{Title: "Feature", Head: "feature", Base: "main"})
+	if err != nil || pr.HTMLURL != "https://github.com/acme/tool/pull/3" {
+		t.Errorf("expected the open pull request from the second page, got %+v, %v", pr, err)
+	}
+}
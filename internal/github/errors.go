@@ -0,0 +1,83 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrNoToken means none of the token sources had a token for the host
+var ErrNoToken = errors.New("no GitHub token")
+
+// Error is a non-2xx answer from the API
+type Error struct {
+	Status  int
+	Message string
+	// Details are the validation messages of a 422
+	Details []string
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if len(e.Details) > 0 {
+		msg += " (" + strings.Join(e.Details, "; ") + ")"
+	}
+	return msg
+}
+
+func newError(status int, body []byte) *Error {
+	var parsed struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Message string `json:"message"`
+			Code    string `json:"code"`
+		} `json:"errors"`
+	}
+	apiErr := &Error{Status: status}
+	if json.Unmarshal(body, &parsed) != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+	apiErr.Message = parsed.Message
+	for _, detail := range parsed.Errors {
+		if detail.Message != "" {
+			apiErr.Details = append(apiErr.Details, detail.Message)
+		} else if detail.Code != "" {
+			apiErr.Details = append(apiErr.Details, detail.Code)
+		}
+	}
+	return apiErr
+}
+
+// RateLimitError is returned when the rate limit resets too far in the future to wait
+type RateLimitError struct {
+	Reset time.Time
+	Err   *Error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("GitHub rate limit exceeded, resets at %s", e.Reset.Local().Format("15:04:05"))
+}
+
+func (e *RateLimitError) Unwrap() error { return e.Err }
+
+func hasStatus(err error, status int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Status == status
+}
+
+// IsNotFound reports a 404, which GitHub also answers for private repos the token can't see
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports a missing, expired or revoked token
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrNoToken) || hasStatus(err, http.StatusUnauthorized)
+}
@@ -0,0 +1,149 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type User struct {
+	Login string `json:"login"`
+	Type  string `json:"type"`
+}
+
+type Repository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
+	HTMLURL  string `json:"html_url"`
+	SSHURL   string `json:"ssh_url"`
+	Owner    User   `json:"owner"`
+}
+
+type Asset struct {
+	Name               string `json:"name"`
+	Size               int64  `json:"size"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+type Release struct {
+	TagName string  `json:"tag_name"`
+	HTMLURL string  `json:"html_url"`
+	Draft   bool    `json:"draft"`
+	Assets  []Asset `json:"assets"`
+}
+
+type PullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+// NewRepository describes a repository to create. An empty Org means the user's account.
+type NewRepository struct {
+	Org         string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+}
+
+type NewPullRequest struct {
+	Title string `json:"title"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Body  string `json:"body"`
+}
+
+func repoPath(owner, name string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
+
+// User is the account the token belongs to
+func (c *Client) User() (*User, error) {
+	var user User
+	if err := c.get("/user", &user); err != nil {
+		return nil, fmt.Errorf("getting GitHub user: %w", err)
+	}
+	return &user, nil
+}
+
+func (c *Client) Repo(owner, name string) (*Repository, error) {
+	var repo Repository
+	if err := c.get(repoPath(owner, name), &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (c *Client) CreateRepo(opts NewRepository) (*Repository, error) {
+	path := "/user/repos"
+	if opts.Org != "" {
+		path = "/orgs/" + url.PathEscape(opts.Org) + "/repos"
+	}
+	var repo Repository
+	if err := c.post(path, opts, &repo); err != nil {
+		return nil, fmt.Errorf("failed to create GitHub repo %s: %w", opts.Name, err)
+	}
+	return &repo, nil
+}
+
+// Release is the release of tag, drafts excluded since the API only finds published ones by tag
+func (c *Client) Release(owner, name, tag string) (*Release, error) {
+	var release Release
+	if err := c.get(repoPath(owner, name)+"/releases/tags/"+url.PathEscape(tag), &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// PullRequests lists pull requests in state (open, closed or all) from head into base.
+// head is owner:branch, either may be empty.
+func (c *Client) PullRequests(owner, name, state, head, base string) ([]PullRequest, error) {
+	query := url.Values{"state": {state}}
+	if head != "" {
+		query.Set("head", head)
+	}
+	if base != "" {
+		query.Set("base", base)
+	}
+	return getAll[PullRequest](c, repoPath(owner, name)+"/pulls?"+query.Encode())
+}
+
+// CreatePullRequest opens a pull request. When one is already open for the branches
+// that one is returned.
+func (c *Client) CreatePullRequest(owner, name string, pr NewPullRequest) (*PullRequest, error) {
+	var created PullRequest
+	err := c.post(repoPath(owner, name)+"/pulls", pr, &created)
+	if err == nil {
+		return &created, nil
+	}
+	if !alreadyExists(err) {
+		return nil, fmt.Errorf("creating PR to %s: %w", pr.Base, err)
+	}
+
+	head := pr.Head
+	if !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+	open, listErr := c.PullRequests(owner, name, "open", head, pr.Base)
+	if listErr != nil {
+		return nil, fmt.Errorf("finding open pull request: %w", listErr)
+	}
+	if len(open) == 0 {
+		return nil, fmt.Errorf("creating PR to %s: %w", pr.Base, err)
+	}
+	return &open[0], nil
+}
+
+func alreadyExists(err error) bool {
+	if !hasStatus(err, http.StatusUnprocessableEntity) {
+		return false
+	}
+	return strings.Contains(err.Error(), "already exists")
+}
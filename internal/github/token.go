@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	tokensMu sync.Mutex
+	tokens   = map[string]string{}
+)
+
+// Token finds a token for host. The environment comes first (GH_TOKEN or GITHUB_TOKEN,
+// GH_ENTERPRISE_TOKEN or GITHUB_ENTERPRISE_TOKEN for Enterprise hosts), then the token
+// gh keeps in hosts.yml, then the system keychain through gh auth token.
+func Token(host string) (string, error) {
+	if host == "" {
+		host = DefaultHost
+	}
+	envs := []string{"GH_TOKEN", "GITHUB_TOKEN"}
+	if host != DefaultHost {
+		envs = []string{"GH_ENTERPRISE_TOKEN", "GITHUB_ENTERPRISE_TOKEN"}
+	}
+	for _, name := range envs {
+		if token := strings.TrimSpace(os.Getenv(name)); token != "" {
+			return token, nil
+		}
+	}
+
+	tokensMu.Lock()
+	defer tokensMu.Unlock()
+	if token, ok := tokens[host]; ok {
+		return token, nil
+	}
+	token := hostsFileToken(host)
+	if token == "" {
+		token = keychainToken(host)
+	}
+	if token == "" {
+		return "", fmt.Errorf("%w for %s: set %s or run gh auth login --hostname %s", ErrNoToken, host, envs[1], host)
+	}
+	tokens[host] = token
+	return token, nil
+}
+
+// ghConfigDir is where gh keeps its config, GH_CONFIG_DIR overrides it like it does for gh
+func ghConfigDir() string {
+	if dir := os.Getenv("GH_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gh")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gh")
+}
+
+// hostsFileToken reads the token gh stores in hosts.yml when no keychain is available
+func hostsFileToken(host string) string {
+	dir := ghConfigDir()
+	if dir == "" {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "hosts.yml"))
+	if err != nil {
+		return ""
+	}
+	var hosts map[string]struct {
+		OAuthToken string `yaml:"oauth_token"`
+	}
+	if yaml.Unmarshal(data, &hosts) != nil {
+		return ""
+	}
+	return hosts[host].OAuthToken
+}
+
+// keychainToken asks gh for the token it keeps in the system keychain
+func keychainToken(host string) string {
+	output, err := exec.Command("gh", "auth", "token", "--hostname", host).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"distui/internal/forge"
 	"distui/internal/models"
 )
 
@@ -72,25 +73,45 @@ func PushCurrentBranch() error {
 	return nil
 }
 
+// CreatePullRequest pushes the current branch and opens a pull request (a merge
+// request on GitLab) into targetBranch
 func CreatePullRequest(targetBranch string) error {
 	// First push current branch
 	if err := PushCurrentBranch(); err != nil {
 		return err
 	}
 
-	// Try to create PR using gh CLI
-	cmd := exec.Command("gh", "pr", "create", "--base", targetBranch, "--fill")
-	output, err := cmd.CombinedOutput()
+	f, remote, err := forge.Detect("")
 	if err != nil {
-		// Check if error is because PR already exists
-		outputStr := string(output)
-		if strings.Contains(outputStr, "pull request already exists") ||
-		   strings.Contains(outputStr, "A pull request already exists") {
-			// PR exists - that's fine, we just pushed to it
-			return nil
-		}
-		return fmt.Errorf("creating PR to %s: %w\nOutput: %s", targetBranch, err, outputStr)
+		return fmt.Errorf("creating PR to %s: %w", targetBranch, err)
+	}
+	branch, err := GetCurrentBranch()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	pr := forge.PullRequest{Head: branch, Base: targetBranch}
+	pr.Title, pr.Body = describeCommits(targetBranch, branch)
+	_, err = f.CreatePullRequest(remote, pr)
+	return err
+}
+
+// describeCommits titles a request after its only commit, or after the branch when
+// there are several, and lists the commit subjects in the body
+func describeCommits(base, branch string) (title, body string) {
+	output, _ := exec.Command("git", "log", "--reverse", "--format=%s", "origin/"+base+"..HEAD").Output()
+	var subjects []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			subjects = append(subjects, line)
+		}
+	}
+	if len(subjects) == 1 {
+		return subjects[0], ""
+	}
+	title = branch
+	for _, subject := range subjects {
+		body += "- " + subject + "\n"
+	}
+	return title, body
 }
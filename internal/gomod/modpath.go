@@ -0,0 +1,280 @@
+package gomod
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// PathCheck describes whether go.mod matches the major version being released.
+type PathCheck struct {
+	Version      string
+	CurrentPath  string
+	ExpectedPath string
+	NeedsUpdate  bool
+}
+
+// MajorVersion returns the major component of a semver tag (v2.3.1 -> 2).
+// Returns -1 if the version is not valid semver.
+func MajorVersion(version string) int {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) {
+		return -1
+	}
+
+	major, err := strconv.Atoi(strings.TrimPrefix(semver.Major(version), "v"))
+	if err != nil {
+		return -1
+	}
+	return major
+}
+
+// IsMajorBump reports whether next moves to a higher major version than current.
+func IsMajorBump(current, next string) bool {
+	nextMajor := MajorVersion(next)
+	if nextMajor < 0 {
+		return false
+	}
+	return nextMajor > MajorVersion(current)
+}
+
+// ModulePathForMajor returns the module path the Go toolchain expects for the
+// given major version. v0 and v1 use the bare path, v2+ require a /vN suffix.
+// gopkg.in paths encode the major version differently and are returned unchanged.
+func ModulePathForMajor(path string, major int) string {
+	if strings.HasPrefix(path, "gopkg.in/") {
+		return path
+	}
+
+	prefix, _, ok := module.SplitPathVersion(path)
+	if !ok {
+		prefix = path
+	}
+
+	if major < 2 {
+		return prefix
+	}
+	return fmt.Sprintf("%s/v%d", prefix, major)
+}
+
+// ReadModulePath returns the module path declared in projectPath/go.mod.
+func ReadModulePath(projectPath string) (string, error) {
+	goModPath := filepath.Join(projectPath, "go.mod")
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return "", fmt.Errorf("reading go.mod: %w", err)
+	}
+
+	modFile, err := modfile.ParseLax(goModPath, data, nil)
+	if err != nil {
+		return "", fmt.Errorf("parsing go.mod: %w", err)
+	}
+	if modFile.Module == nil {
+		return "", fmt.Errorf("no module declaration found in go.mod")
+	}
+
+	return modFile.Module.Mod.Path, nil
+}
+
+// CheckModulePath compares the module path in go.mod with the path required
+// to tag version.
+func CheckModulePath(projectPath, version string) (*PathCheck, error) {
+	major := MajorVersion(version)
+	if major < 0 {
+		return nil, fmt.Errorf("invalid version: %s", version)
+	}
+
+	currentPath, err := ReadModulePath(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedPath := ModulePathForMajor(currentPath, major)
+
+	return &PathCheck{
+		Version:      version,
+		CurrentPath:  currentPath,
+		ExpectedPath: expectedPath,
+		NeedsUpdate:  currentPath != expectedPath,
+	}, nil
+}
+
+// RewriteModulePath changes the module declaration in go.mod from oldPath to
+// newPath and rewrites every import of oldPath (or its packages) in the
+// module's .go files. Returns the files that were modified, relative to projectPath.
+// Every file is parsed and rewritten in memory first, a failure leaves the tree as it was.
+func RewriteModulePath(projectPath, oldPath, newPath string) ([]string, error) {
+	if oldPath == "" || newPath == "" {
+		return nil, fmt.Errorf("module paths required")
+	}
+	if oldPath == newPath {
+		return nil, nil
+	}
+
+	goModPath := filepath.Join(projectPath, "go.mod")
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod: %w", err)
+	}
+
+	modFile, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parsing go.mod: %w", err)
+	}
+	if modFile.Module == nil || modFile.Module.Mod.Path != oldPath {
+		return nil, fmt.Errorf("go.mod does not declare module %s", oldPath)
+	}
+
+	if err := modFile.AddModuleStmt(newPath); err != nil {
+		return nil, fmt.Errorf("updating module statement: %w", err)
+	}
+
+	formatted, err := modFile.Format()
+	if err != nil {
+		return nil, fmt.Errorf("formatting go.mod: %w", err)
+	}
+
+	rewrites := []fileRewrite{{path: goModPath, rel: "go.mod", old: data, new: formatted}}
+
+	err = filepath.WalkDir(projectPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path == projectPath {
+				return nil
+			}
+			name := d.Name()
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			// Nested modules own their imports
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		updated, err := rewriteImports(path, src, oldPath, newPath)
+		if err != nil {
+			return err
+		}
+		if updated != nil {
+			rel, err := filepath.Rel(projectPath, path)
+			if err != nil {
+				rel = path
+			}
+			rewrites = append(rewrites, fileRewrite{path: path, rel: rel, old: src, new: updated})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rewriting imports: %w", err)
+	}
+
+	return writeRewrites(rewrites)
+}
+
+type fileRewrite struct {
+	path string
+	rel  string
+	old  []byte
+	new  []byte
+}
+
+// writeRewrites writes every file, on a failure the files already written get their
+// old content back
+func writeRewrites(rewrites []fileRewrite) ([]string, error) {
+	changed := make([]string, 0, len(rewrites))
+	for i, rewrite := range rewrites {
+		if err := writeKeepingMode(rewrite.path, rewrite.new); err != nil {
+			for _, done := range rewrites[:i] {
+				writeKeepingMode(done.path, done.old)
+			}
+			return nil, fmt.Errorf("writing %s: %w", rewrite.rel, err)
+		}
+		changed = append(changed, rewrite.rel)
+	}
+	return changed, nil
+}
+
+func writeKeepingMode(path string, data []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, info.Mode().Perm())
+}
+
+type importEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// rewriteImports edits import paths in src so formatting and comments are kept.
+// Returns nil when no import changes.
+func rewriteImports(filePath string, src []byte, oldPath, newPath string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filePath, err)
+	}
+
+	var edits []importEdit
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		var updated string
+		switch {
+		case importPath == oldPath:
+			updated = newPath
+		case strings.HasPrefix(importPath, oldPath+"/"):
+			updated = newPath + strings.TrimPrefix(importPath, oldPath)
+		default:
+			continue
+		}
+
+		edits = append(edits, importEdit{
+			start: fset.Position(spec.Path.Pos()).Offset,
+			end:   fset.Position(spec.Path.End()).Offset,
+			text:  strconv.Quote(updated),
+		})
+	}
+
+	if len(edits) == 0 {
+		return nil, nil
+	}
+
+	// Apply from the end so earlier offsets stay valid
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	out := append([]byte{}, src...)
+	for _, e := range edits {
+		out = append(out[:e.start], append([]byte(e.text), out[e.end:]...)...)
+	}
+	return out, nil
+}
@@ -0,0 +1,134 @@
+package gomod
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestModulePathForMajor(t *testing.T) {
+	tests := []struct {
+		path     string
+		major    int
+		expected string
+	}{
+		{"github.com/user/tool", 0, "github.com/user/tool"},
+		{"github.com/user/tool", 1, "github.com/user/tool"},
+		{"github.com/user/tool", 2, "github.com/user/tool/v2"},
+		{"github.com/user/tool/v2", 3, "github.com/user/tool/v3"},
+		{"github.com/user/tool/v3", 1, "github.com/user/tool"},
+		{"gopkg.in/yaml.v3", 4, "gopkg.in/yaml.v3"},
+	}
+
+	for _, tt := range tests {
+		got := ModulePathForMajor(tt.path, tt.major)
+		if got != tt.expected {
+			t.Errorf("ModulePathForMajor(%q, %d) = %q, want %q", tt.path, tt.major, got, tt.expected)
+		}
+	}
+}
+
+func TestIsMajorBump(t *testing.T) {
+	if !IsMajorBump("v1.4.2", "v2.0.0") {
+		t.Error("Expected v1.4.2 -> v2.0.0 to be a major bump")
+	}
+	if IsMajorBump("v1.4.2", "v1.5.0") {
+		t.Error("Expected v1.4.2 -> v1.5.0 not to be a major bump")
+	}
+	if IsMajorBump("v1.4.2", "not-a-version") {
+		t.Error("Expected invalid version not to be a major bump")
+	}
+}
+
+func TestRewriteModulePath(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod": "module github.com/user/tool\n\ngo 1.21\n",
+		"main.go": `package main
+
+import (
+	"fmt"
+
+	"github.com/user/tool/internal/app" // app wiring
+	"github.com/user/toolkit"
+)
+
+func main() { fmt.Println(app.Name, toolkit.X) }
+`,
+		"internal/app/app.go":              "package app\n\nconst Name = \"tool\"\n",
+		"vendor/github.com/user/tool/x.go": "package x\n\nimport _ \"github.com/user/tool\"\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Setup failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Setup failed: %v", err)
+		}
+	}
+
+	changed, err := RewriteModulePath(tmpDir, "github.com/user/tool", "github.com/user/tool/v2")
+	if err != nil {
+		t.Fatalf("RewriteModulePath failed: %v", err)
+	}
+
+	if len(changed) != 2 {
+		t.Errorf("Expected go.mod and main.go to change, got %v", changed)
+	}
+
+	modPath, err := ReadModulePath(tmpDir)
+	if err != nil {
+		t.Fatalf("ReadModulePath failed: %v", err)
+	}
+	if modPath != "github.com/user/tool/v2" {
+		t.Errorf("Expected module path to be rewritten, got %s", modPath)
+	}
+
+	mainSrc, _ := os.ReadFile(filepath.Join(tmpDir, "main.go"))
+	if !strings.Contains(string(mainSrc), `"github.com/user/tool/v2/internal/app" // app wiring`) {
+		t.Errorf("Internal import not rewritten or comment lost:\n%s", mainSrc)
+	}
+	if !strings.Contains(string(mainSrc), `"github.com/user/toolkit"`) {
+		t.Errorf("Unrelated import with shared prefix was modified:\n%s", mainSrc)
+	}
+
+	vendorSrc, _ := os.ReadFile(filepath.Join(tmpDir, "vendor/github.com/user/tool/x.go"))
+	if strings.Contains(string(vendorSrc), "/v2") {
+		t.Error("Vendored files should not be rewritten")
+	}
+}
+
+func TestRewriteModulePathLeavesTreeOnParseError(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":    "module github.com/user/tool\n\ngo 1.21\n",
+		"a.go":      "package tool\n\nimport _ \"github.com/user/tool/internal/app\"\n",
+		"broken.go": "package tool\n\nimport (\n",
+		"z/z.go":    "package z\n\nimport _ \"github.com/user/tool\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Setup failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Setup failed: %v", err)
+		}
+	}
+
+	if _, err := RewriteModulePath(tmpDir, "github.com/user/tool", "github.com/user/tool/v2"); err == nil {
+		t.Fatal("Expected a parse error")
+	}
+
+	for name, content := range files {
+		data, _ := os.ReadFile(filepath.Join(tmpDir, name))
+		if string(data) != content {
+			t.Errorf("%s was modified despite the error:\n%s", name, data)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package gomod
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// VendorHash returns the vendorHash nixpkgs' buildGoModule expects: the sha256 of the
+// NAR serialization of what "go mod vendor" produces, in SRI form. An empty hash means
+// the module has no dependencies and vendorHash must be null.
+func VendorHash(projectPath string) (string, error) {
+	tmp, err := os.MkdirTemp("", "distui-vendor-*")
+	if err != nil {
+		return "", fmt.Errorf("creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	vendorDir := filepath.Join(tmp, "vendor")
+	cmd := exec.Command("go", "mod", "vendor", "-o", vendorDir)
+	cmd.Dir = projectPath
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if strings.Contains(string(output), "no dependencies to vendor") {
+			return "", nil
+		}
+		return "", fmt.Errorf("go mod vendor: %s", strings.TrimSpace(string(output)))
+	}
+	if _, err := os.Stat(vendorDir); os.IsNotExist(err) {
+		return "", nil
+	}
+
+	return NARHash(vendorDir)
+}
+
+// NARHash returns the SRI sha256 of the Nix archive serialization of path
+func NARHash(path string) (string, error) {
+	h := sha256.New()
+	nar := &narWriter{w: h}
+	nar.str("nix-archive-1")
+	if err := nar.node(path); err != nil {
+		return "", err
+	}
+	if nar.err != nil {
+		return "", nar.err
+	}
+	return "sha256-" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// narWriter writes the NAR format: every string is length prefixed (uint64 little
+// endian) and padded to 8 bytes, directory entries are sorted by name.
+type narWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (n *narWriter) str(s string) {
+	n.bytes([]byte(s))
+}
+
+func (n *narWriter) bytes(data []byte) {
+	if n.err != nil {
+		return
+	}
+	var length [8]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(data)))
+	if _, n.err = n.w.Write(length[:]); n.err != nil {
+		return
+	}
+	if _, n.err = n.w.Write(data); n.err != nil {
+		return
+	}
+	if pad := (8 - len(data)%8) % 8; pad > 0 {
+		_, n.err = n.w.Write(make([]byte, pad))
+	}
+}
+
+func (n *narWriter) node(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	n.str("(")
+	n.str("type")
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(path)
+		if err != nil {
+			return fmt.Errorf("reading link %s: %w", path, err)
+		}
+		n.str("symlink")
+		n.str("target")
+		n.str(target)
+	case info.IsDir():
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return fmt.Errorf("reading dir %s: %w", path, err)
+		}
+		names := make([]string, len(entries))
+		for i, entry := range entries {
+			names[i] = entry.Name()
+		}
+		sort.Strings(names)
+
+		n.str("directory")
+		for _, name := range names {
+			n.str("entry")
+			n.str("(")
+			n.str("name")
+			n.str(name)
+			n.str("node")
+			if err := n.node(filepath.Join(path, name)); err != nil {
+				return err
+			}
+			n.str(")")
+		}
+	default:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		n.str("regular")
+		if info.Mode()&0111 != 0 {
+			n.str("executable")
+			n.str("")
+		}
+		n.str("contents")
+		n.bytes(data)
+	}
+	n.str(")")
+	return nil
+}
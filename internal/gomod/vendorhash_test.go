@@ -0,0 +1,75 @@
+package gomod
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func narString(buf *bytes.Buffer, s string) {
+	binary.Write(buf, binary.LittleEndian, uint64(len(s)))
+	buf.WriteString(s)
+	for buf.Len()%8 != 0 {
+		buf.WriteByte(0)
+	}
+}
+
+func TestNARHashRegularFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, []byte("hello\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var want bytes.Buffer
+	for _, s := range []string{"nix-archive-1", "(", "type", "regular", "contents", "hello\n", ")"} {
+		narString(&want, s)
+	}
+	sum := sha256.Sum256(want.Bytes())
+
+	got, err := NARHash(path)
+	if err != nil {
+		t.Fatalf("NARHash failed: %v", err)
+	}
+	if expected := "sha256-" + base64.StdEncoding.EncodeToString(sum[:]); got != expected {
+		t.Errorf("NARHash() = %s, want %s", got, expected)
+	}
+}
+
+func TestNARHashDirectory(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "b"), 0755)
+	os.WriteFile(filepath.Join(dir, "b", "x.go"), []byte("package b\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+
+	first, err := NARHash(dir)
+	if err != nil {
+		t.Fatalf("NARHash failed: %v", err)
+	}
+	again, _ := NARHash(dir)
+	if first != again {
+		t.Errorf("NARHash is not deterministic: %s != %s", first, again)
+	}
+
+	os.Chmod(filepath.Join(dir, "a.txt"), 0755)
+	if executable, _ := NARHash(dir); executable == first {
+		t.Error("Expected the executable bit to change the hash")
+	}
+}
+
+func TestVendorHashWithoutDependencies(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/tool\n\ngo 1.21\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644)
+
+	hash, err := VendorHash(dir)
+	if err != nil {
+		t.Fatalf("VendorHash failed: %v", err)
+	}
+	if hash != "" {
+		t.Errorf("VendorHash() = %q, want empty for a module without dependencies", hash)
+	}
+}
@@ -0,0 +1,512 @@
+package goreleaser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Change is one rewrite made by Migrate, or one it could not make on its own
+type Change struct {
+	Line        int
+	Path        string
+	Description string
+	Manual      bool // Left untouched, needs a hand edit
+}
+
+// Migrate rewrites deprecated and removed keys in a GoReleaser config to their
+// current equivalents. Edits are made on the source text at the positions the
+// YAML parser reports, so comments, ordering and formatting are kept.
+func Migrate(data []byte) (string, []Change, error) {
+	root, err := RootSchema()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", nil, fmt.Errorf("parsing goreleaser config: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return string(data), nil, nil
+	}
+
+	m := newMigrator(string(data))
+	m.mapping(doc.Content[0], root, "", len(m.lineStarts)+1)
+
+	if len(m.edits) == 0 {
+		return string(data), m.changes, nil
+	}
+
+	result := m.apply()
+
+	// Never hand back a config that no longer parses
+	var check yaml.Node
+	if err := yaml.Unmarshal([]byte(result), &check); err != nil {
+		return "", nil, fmt.Errorf("migrated config is invalid: %w", err)
+	}
+
+	return result, m.changes, nil
+}
+
+// Applied returns the changes Migrate actually made
+func Applied(changes []Change) []Change {
+	var applied []Change
+	for _, c := range changes {
+		if !c.Manual {
+			applied = append(applied, c)
+		}
+	}
+	return applied
+}
+
+type textEdit struct {
+	start, end int
+	text       string
+}
+
+type migrator struct {
+	content    string
+	lineStarts []int
+	edits      []textEdit
+	changes    []Change
+}
+
+func newMigrator(content string) *migrator {
+	starts := []int{0}
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return &migrator{content: content, lineStarts: starts}
+}
+
+func (m *migrator) change(n *yaml.Node, path, description string, manual bool) {
+	m.changes = append(m.changes, Change{Line: n.Line, Path: path, Description: description, Manual: manual})
+}
+
+func (m *migrator) mapping(n *yaml.Node, s *Schema, path string, end int) {
+	if s.Fields == nil {
+		return
+	}
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		keyNode, valueNode := n.Content[i], n.Content[i+1]
+		key := keyNode.Value
+		if key == "<<" || valueNode.Kind == yaml.AliasNode {
+			continue
+		}
+
+		entryEnd := end
+		if i+2 < len(n.Content) {
+			entryEnd = n.Content[i+2].Line
+		}
+		keyPath := joinPath(path, key)
+
+		field, ok := s.Fields[key]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case path == "" && key == "brews":
+			m.brewsToCasks(n, i, s, entryEnd)
+			continue
+
+		case field.Replacement != "":
+			if !m.replaceKey(n, i, s, keyPath) {
+				continue
+			}
+			field = s.Fields[field.Replacement]
+
+		case field.Removed != "":
+			if m.removeEntries(n, []int{i}, end) {
+				m.change(keyNode, keyPath, fmt.Sprintf("removed %s (%s)", key, field.Removed), false)
+			} else {
+				m.change(keyNode, keyPath, fmt.Sprintf("remove %s by hand (%s)", key, field.Removed), true)
+			}
+			continue
+		}
+
+		m.node(valueNode, field, keyPath, entryEnd)
+	}
+}
+
+func (m *migrator) node(n *yaml.Node, s *Schema, path string, end int) {
+	s = s.resolve()
+	if s == nil {
+		return
+	}
+
+	switch n.Kind {
+	case yaml.MappingNode:
+		if s.allows("object") {
+			m.mapping(n, s, path, end)
+		}
+	case yaml.SequenceNode:
+		if s.Items == nil {
+			return
+		}
+		for i, item := range n.Content {
+			itemEnd := end
+			if i+1 < len(n.Content) {
+				itemEnd = n.Content[i+1].Line
+			}
+			m.node(item, s.Items, fmt.Sprintf("%s[%d]", path, i), itemEnd)
+		}
+	}
+}
+
+// replaceKey renames the deprecated key at n.Content[i] to its replacement.
+// A scalar moving to a list-only key is wrapped, e.g. format: zip -> formats: [zip].
+func (m *migrator) replaceKey(n *yaml.Node, i int, s *Schema, path string) bool {
+	keyNode, valueNode := n.Content[i], n.Content[i+1]
+	key := keyNode.Value
+	target := s.Fields[key].Replacement
+
+	if keyIndex(n, target) >= 0 {
+		m.change(keyNode, path, fmt.Sprintf("both %s and %s are set, remove %s", key, target, key), true)
+		return false
+	}
+
+	targetSchema := s.Fields[target].resolve()
+	if valueNode.Kind == yaml.ScalarNode && valueNode.Tag != "!!null" && targetSchema != nil &&
+		targetSchema.allows("list") && !s.Fields[key].resolve().allows("list") {
+		if !m.replaceScalar(valueNode, "["+m.raw(valueNode)+"]") {
+			m.change(keyNode, path, fmt.Sprintf("rewrite %s to %s by hand", key, target), true)
+			return false
+		}
+		m.rename(keyNode, target)
+		m.change(keyNode, path, fmt.Sprintf("%s: %s → %s: [%s]", key, valueNode.Value, target, valueNode.Value), false)
+		return true
+	}
+
+	if !m.rename(keyNode, target) {
+		m.change(keyNode, path, fmt.Sprintf("rewrite %s to %s by hand", key, target), true)
+		return false
+	}
+	m.change(keyNode, path, fmt.Sprintf("%s → %s", key, target), false)
+	return true
+}
+
+// brewsToCasks turns formula entries into casks. Formula-only keys have no
+// cask equivalent and are dropped.
+func (m *migrator) brewsToCasks(n *yaml.Node, i int, s *Schema, end int) {
+	keyNode, valueNode := n.Content[i], n.Content[i+1]
+
+	if keyIndex(n, "homebrew_casks") >= 0 {
+		m.change(keyNode, "brews", "both brews and homebrew_casks are set, move the remaining formulas by hand", true)
+		return
+	}
+
+	m.rename(keyNode, "homebrew_casks")
+	m.change(keyNode, "brews", "brews → homebrew_casks (users install with brew install --cask)", false)
+
+	if valueNode.Kind != yaml.SequenceNode {
+		return
+	}
+	cask := s.Fields["homebrew_casks"].resolve().Items.resolve()
+	for j, item := range valueNode.Content {
+		itemEnd := end
+		if j+1 < len(valueNode.Content) {
+			itemEnd = valueNode.Content[j+1].Line
+		}
+		if item.Kind == yaml.MappingNode {
+			m.formulaToCask(item, cask, fmt.Sprintf("homebrew_casks[%d]", j), itemEnd)
+		}
+	}
+}
+
+func (m *migrator) formulaToCask(item *yaml.Node, cask *Schema, path string, end int) {
+	var drop []int
+	var dropped []Change
+
+	for i := 0; i+1 < len(item.Content); i += 2 {
+		keyNode, valueNode := item.Content[i], item.Content[i+1]
+		key := keyNode.Value
+		keyPath := joinPath(path, key)
+
+		entryEnd := end
+		if i+2 < len(item.Content) {
+			entryEnd = item.Content[i+2].Line
+		}
+
+		switch key {
+		case "url_template":
+			// url_template: X -> url:\n  template: X
+			indent := strings.Repeat(" ", keyNode.Column+1)
+			if item.Style&yaml.FlowStyle != 0 || !m.rename(keyNode, "url:\n"+indent+"template") {
+				m.change(keyNode, keyPath, "move url_template to url.template by hand", true)
+				continue
+			}
+			m.change(keyNode, keyPath, "url_template → url.template", false)
+
+		case "tap", "folder":
+			target := "repository"
+			if key == "folder" {
+				target = "directory"
+			}
+			if keyIndex(item, target) >= 0 {
+				drop = append(drop, i)
+				dropped = append(dropped, Change{Line: keyNode.Line, Path: keyPath, Description: fmt.Sprintf("removed %s, %s is already set", key, target)})
+				continue
+			}
+			m.rename(keyNode, target)
+			m.change(keyNode, keyPath, fmt.Sprintf("%s → %s", key, target), false)
+			if key == "folder" {
+				m.formulaDirectory(valueNode, joinPath(path, target))
+			}
+
+		case "directory":
+			m.formulaDirectory(valueNode, keyPath)
+
+		case "dependencies":
+			for d, dep := range valueNode.Content {
+				if dep.Kind != yaml.MappingNode {
+					continue
+				}
+				depEnd := entryEnd
+				if d+1 < len(valueNode.Content) {
+					depEnd = valueNode.Content[d+1].Line
+				}
+				// os, type and version have no cask equivalent
+				var depDrop []int
+				for k := 0; k+1 < len(dep.Content); k += 2 {
+					switch dep.Content[k].Value {
+					case "name":
+						m.rename(dep.Content[k], "formula")
+					case "formula", "cask":
+					default:
+						depDrop = append(depDrop, k)
+					}
+				}
+				if len(depDrop) > 0 && !m.removeEntries(dep, depDrop, depEnd) {
+					m.change(dep, fmt.Sprintf("%s[%d]", keyPath, d), "remove os/type/version by hand", true)
+				}
+			}
+			m.change(keyNode, keyPath, "dependencies: name → formula", false)
+
+		case "conflicts":
+			for _, conflict := range valueNode.Content {
+				switch conflict.Kind {
+				case yaml.ScalarNode:
+					m.insert(conflict, "formula: ")
+				case yaml.MappingNode:
+					if idx := keyIndex(conflict, "name"); idx >= 0 {
+						m.rename(conflict.Content[idx], "formula")
+					}
+				}
+			}
+			m.change(keyNode, keyPath, "conflicts now name the formula", false)
+
+		default:
+			if _, ok := cask.Fields[key]; ok {
+				continue
+			}
+			drop = append(drop, i)
+			dropped = append(dropped, Change{Line: keyNode.Line, Path: keyPath, Description: fmt.Sprintf("dropped %s, casks don't support it", key)})
+		}
+	}
+
+	if len(drop) == 0 {
+		return
+	}
+	manual := !m.removeEntries(item, drop, end)
+	for _, c := range dropped {
+		if manual {
+			c.Description = "remove by hand: " + c.Description
+		}
+		c.Manual = manual
+		m.changes = append(m.changes, c)
+	}
+}
+
+// formulaDirectory points the conventional Formula directory at Casks
+func (m *migrator) formulaDirectory(value *yaml.Node, path string) {
+	if value.Kind == yaml.ScalarNode && value.Value == "Formula" && m.replaceScalar(value, "Casks") {
+		m.change(value, path, "directory: Formula → Casks", false)
+	}
+}
+
+func (m *migrator) rename(key *yaml.Node, name string) bool {
+	start, end := m.scalarRange(key)
+	if start < 0 {
+		return false
+	}
+	m.edits = append(m.edits, textEdit{start: start, end: end, text: name})
+	return true
+}
+
+func (m *migrator) replaceScalar(n *yaml.Node, text string) bool {
+	start, end := m.scalarRange(n)
+	if start < 0 {
+		return false
+	}
+	m.edits = append(m.edits, textEdit{start: start, end: end, text: text})
+	return true
+}
+
+func (m *migrator) insert(n *yaml.Node, text string) {
+	start := m.offset(n.Line, n.Column)
+	m.edits = append(m.edits, textEdit{start: start, end: start, text: text})
+}
+
+func (m *migrator) raw(n *yaml.Node) string {
+	start, end := m.scalarRange(n)
+	if start < 0 {
+		return n.Value
+	}
+	return m.content[start:end]
+}
+
+// removeEntries deletes the key/value pairs at the given key indexes of n.
+// end is the first line after n. Blank lines and comments right before a
+// kept key stay, since they belong to it.
+func (m *migrator) removeEntries(n *yaml.Node, drop []int, end int) bool {
+	if n.Style&yaml.FlowStyle != 0 {
+		return false
+	}
+
+	dropped := map[int]bool{}
+	for _, i := range drop {
+		dropped[i] = true
+	}
+
+	for _, i := range drop {
+		keyNode := n.Content[i]
+		entryEnd := end
+		if i+2 < len(n.Content) {
+			entryEnd = n.Content[i+2].Line
+		}
+		for entryEnd > keyNode.Line+1 {
+			text := strings.TrimSpace(m.line(entryEnd - 1))
+			if text == "" || strings.HasPrefix(text, "#") {
+				entryEnd--
+				continue
+			}
+			break
+		}
+
+		keyStart := m.offset(keyNode.Line, keyNode.Column)
+		if strings.TrimSpace(m.content[m.lineOffset(keyNode.Line):keyStart]) == "" {
+			m.edits = append(m.edits, textEdit{start: m.lineOffset(keyNode.Line), end: m.lineOffset(entryEnd)})
+			continue
+		}
+
+		// The key shares its line with a list dash: move the first kept key up
+		// next to the dash. Keys are aligned, so its nested lines still fit.
+		survivor := -1
+		for k := 0; k+1 < len(n.Content); k += 2 {
+			if !dropped[k] {
+				survivor = k
+				break
+			}
+		}
+		if survivor < 0 {
+			return false
+		}
+		next := n.Content[survivor]
+		rest := m.line(next.Line)[m.offset(next.Line, next.Column)-m.lineOffset(next.Line):]
+		m.edits = append(m.edits,
+			textEdit{start: keyStart, end: m.lineOffset(entryEnd), text: rest + "\n"},
+			textEdit{start: m.lineOffset(next.Line), end: m.lineOffset(next.Line + 1)},
+		)
+	}
+	return true
+}
+
+// scalarRange returns the byte range of a single-line scalar in the source
+func (m *migrator) scalarRange(n *yaml.Node) (int, int) {
+	if n.Kind != yaml.ScalarNode || n.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return -1, -1
+	}
+
+	start := m.offset(n.Line, n.Column)
+	text := m.line(n.Line)[start-m.lineOffset(n.Line):]
+
+	switch {
+	case n.Style&yaml.DoubleQuotedStyle != 0:
+		for i := 1; i < len(text); i++ {
+			if text[i] == '\\' {
+				i++
+				continue
+			}
+			if text[i] == '"' {
+				return start, start + i + 1
+			}
+		}
+	case n.Style&yaml.SingleQuotedStyle != 0:
+		for i := 1; i < len(text); i++ {
+			if text[i] != '\'' {
+				continue
+			}
+			if i+1 < len(text) && text[i+1] == '\'' {
+				i++
+				continue
+			}
+			return start, start + i + 1
+		}
+	default:
+		if strings.HasPrefix(text, n.Value) {
+			return start, start + len(n.Value)
+		}
+	}
+	return -1, -1
+}
+
+// offset converts a 1-based line and rune column to a byte offset
+func (m *migrator) offset(line, column int) int {
+	start := m.lineOffset(line)
+	text := m.line(line)
+	i := 0
+	for c := 1; c < column && i < len(text); c++ {
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return start + i
+}
+
+func (m *migrator) lineOffset(line int) int {
+	if line-1 < len(m.lineStarts) {
+		return m.lineStarts[line-1]
+	}
+	return len(m.content)
+}
+
+func (m *migrator) line(line int) string {
+	start := m.lineOffset(line)
+	end := len(m.content)
+	if line < len(m.lineStarts) {
+		end = m.lineStarts[line] - 1
+	}
+	if start > end {
+		return ""
+	}
+	return m.content[start:end]
+}
+
+func (m *migrator) apply() string {
+	edits := append([]textEdit(nil), m.edits...)
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+
+	result := m.content
+	for _, e := range edits {
+		result = result[:e.start] + e.text + result[e.end:]
+	}
+	return result
+}
+
+func keyIndex(n *yaml.Node, key string) int {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
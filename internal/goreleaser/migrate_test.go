@@ -0,0 +1,165 @@
+package goreleaser
+
+import (
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		changes int
+	}{
+		{
+			name: "archive formats keep comments",
+			input: `version: 2
+
+# Archives
+archives:
+  - format: tar.gz # default
+    format_overrides:
+      - goos: windows
+        format: 'zip'
+`,
+			want: `version: 2
+
+# Archives
+archives:
+  - formats: [tar.gz] # default
+    format_overrides:
+      - goos: windows
+        formats: ['zip']
+`,
+			changes: 2,
+		},
+		{
+			name: "renames and removed keys",
+			input: `version: 2
+snapshot:
+  name_template: "{{ .Tag }}-next"
+archives:
+  - builds: [tool]
+    rlcp: true
+    files:
+      - README.md
+changelog:
+  skip: true
+`,
+			want: `version: 2
+snapshot:
+  version_template: "{{ .Tag }}-next"
+archives:
+  - ids: [tool]
+    files:
+      - README.md
+changelog:
+  disable: true
+`,
+			changes: 4,
+		},
+		{
+			name: "formula to cask",
+			input: `version: 2
+brews:
+  - name: tool
+    url_template: "https://example.com/{{ .ArtifactName }}"
+    repository:
+      owner: acme
+      name: homebrew-tap
+    directory: Formula
+    dependencies:
+      - name: git
+        os: mac
+    install: |
+      bin.install "tool"
+    test: |
+      system "#{bin}/tool", "--version"
+
+# Keep me
+checksum:
+  name_template: checksums.txt
+`,
+			want: `version: 2
+homebrew_casks:
+  - name: tool
+    url:
+      template: "https://example.com/{{ .ArtifactName }}"
+    repository:
+      owner: acme
+      name: homebrew-tap
+    directory: Casks
+    dependencies:
+      - formula: git
+
+# Keep me
+checksum:
+  name_template: checksums.txt
+`,
+			changes: 6,
+		},
+		{
+			name: "dropped first key of a list item",
+			input: `version: 2
+brews:
+  - goarm: "7"
+    name: tool
+    description: Tool
+`,
+			want: `version: 2
+homebrew_casks:
+  - name: tool
+    description: Tool
+`,
+			changes: 2,
+		},
+		{
+			name:    "nothing to do",
+			input:   "version: 2\narchives:\n  - formats: [zip]\n",
+			want:    "version: 2\narchives:\n  - formats: [zip]\n",
+			changes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changes, err := Migrate([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("Migrate failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Migrate output mismatch\ngot:\n%s\nwant:\n%s", got, tt.want)
+			}
+			if len(Applied(changes)) != tt.changes {
+				t.Errorf("Expected %d changes, got %v", tt.changes, changes)
+			}
+
+			// The result is clean and migrating again is a no-op
+			issues, _ := Validate([]byte(got))
+			for _, issue := range issues {
+				if issue.Fixable {
+					t.Errorf("Migrated config still has %v", issue)
+				}
+			}
+			again, _, err := Migrate([]byte(got))
+			if err != nil || again != got {
+				t.Errorf("Second migration changed the config:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestMigrateConflict(t *testing.T) {
+	input := "version: 2\narchives:\n  - format: zip\n    formats: [zip]\n"
+
+	got, changes, err := Migrate([]byte(input))
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if got != input {
+		t.Errorf("Expected config to be left alone, got:\n%s", got)
+	}
+	if len(changes) != 1 || !changes[0].Manual {
+		t.Errorf("Expected one manual change, got %v", changes)
+	}
+}
@@ -0,0 +1,125 @@
+package goreleaser
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed schema.yaml
+var schemaYAML []byte
+
+// Schema describes one node of a GoReleaser config
+type Schema struct {
+	Type        string             `yaml:"type"`
+	Fields      map[string]*Schema `yaml:"fields"`
+	Items       *Schema            `yaml:"items"`
+	Values      *Schema            `yaml:"values"`
+	Enum        []string           `yaml:"enum"`
+	Ref         string             `yaml:"ref"`
+	Deprecated  string             `yaml:"deprecated"`
+	Replacement string             `yaml:"replacement"`
+	Removed     string             `yaml:"removed"`
+}
+
+type schemaFile struct {
+	Definitions map[string]*Schema `yaml:"definitions"`
+	Root        *Schema            `yaml:"root"`
+}
+
+var (
+	loadOnce    sync.Once
+	loadedRoot  *Schema
+	loadErr     error
+	definitions map[string]*Schema
+)
+
+// RootSchema returns the embedded GoReleaser v2 schema
+func RootSchema() (*Schema, error) {
+	loadOnce.Do(func() {
+		var file schemaFile
+		if err := yaml.Unmarshal(schemaYAML, &file); err != nil {
+			loadErr = fmt.Errorf("parsing embedded schema: %w", err)
+			return
+		}
+		if file.Root == nil {
+			loadErr = fmt.Errorf("embedded schema has no root")
+			return
+		}
+		definitions = file.Definitions
+		loadedRoot = file.Root
+	})
+	return loadedRoot, loadErr
+}
+
+// resolve follows ref to its definition, keeping any deprecation set on the referencing key
+func (s *Schema) resolve() *Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	def, ok := definitions[s.Ref]
+	if !ok {
+		return s
+	}
+	if s.Deprecated == "" && s.Removed == "" {
+		return def
+	}
+	merged := *def
+	merged.Deprecated = s.Deprecated
+	merged.Replacement = s.Replacement
+	merged.Removed = s.Removed
+	return &merged
+}
+
+// types returns the allowed types, e.g. "string|object" -> [string object]
+func (s *Schema) types() []string {
+	if s.Type == "" {
+		return []string{"any"}
+	}
+	return strings.Split(s.Type, "|")
+}
+
+func (s *Schema) allows(t string) bool {
+	for _, allowed := range s.types() {
+		if allowed == t || allowed == "any" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldNames returns the known keys of an object schema, sorted
+func (s *Schema) fieldNames() []string {
+	names := make([]string, 0, len(s.Fields))
+	for name := range s.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the schema for a dotted path like "archives.format_overrides.format".
+// List levels are skipped implicitly.
+func Lookup(path string) *Schema {
+	root, err := RootSchema()
+	if err != nil {
+		return nil
+	}
+
+	current := root
+	for _, part := range strings.Split(path, ".") {
+		current = current.resolve()
+		for current != nil && current.Items != nil && current.Fields == nil {
+			current = current.Items.resolve()
+		}
+		if current == nil || current.Fields == nil {
+			return nil
+		}
+		current = current.Fields[part]
+	}
+	return current.resolve()
+}
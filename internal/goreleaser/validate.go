@@ -0,0 +1,373 @@
+package goreleaser
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a single validation finding, positioned in the source file
+type Issue struct {
+	Line       int
+	Column     int
+	Path       string // Dotted key path, list indexes in brackets
+	Severity   Severity
+	Message    string
+	Suggestion string
+	Fixable    bool // Migrate can rewrite it
+}
+
+func (i Issue) String() string {
+	s := fmt.Sprintf("%d:%d %s: %s", i.Line, i.Column, i.Severity, i.Message)
+	if i.Suggestion != "" {
+		s += " (" + i.Suggestion + ")"
+	}
+	return s
+}
+
+// HasErrors reports whether any issue would make GoReleaser reject the config
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFixable reports whether Migrate can rewrite any of the issues
+func HasFixable(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Fixable {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns only the error-level issues
+func Errors(issues []Issue) []Issue {
+	var errs []Issue
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			errs = append(errs, issue)
+		}
+	}
+	return errs
+}
+
+var yamlLineRe = regexp.MustCompile(`line (\d+)`)
+
+// Validate checks a GoReleaser config against the embedded v2 schema.
+// YAML syntax errors are reported as issues too, so the caller gets one list.
+func Validate(data []byte) ([]Issue, error) {
+	root, err := RootSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		line := 0
+		if m := yamlLineRe.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+		}
+		return []Issue{{
+			Line:     line,
+			Column:   1,
+			Severity: SeverityError,
+			Message:  strings.TrimPrefix(err.Error(), "yaml: "),
+		}}, nil
+	}
+
+	if len(doc.Content) == 0 {
+		return []Issue{{Line: 1, Column: 1, Severity: SeverityError, Message: "config is empty"}}, nil
+	}
+
+	v := &validator{}
+	top := doc.Content[0]
+	v.node(top, root, "")
+
+	if top.Kind == yaml.MappingNode {
+		v.checkVersion(top)
+	}
+
+	return v.issues, nil
+}
+
+// ValidateFile validates the config at path
+func ValidateFile(path string) ([]Issue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading goreleaser config: %w", err)
+	}
+	return Validate(data)
+}
+
+type validator struct {
+	issues []Issue
+}
+
+func (v *validator) add(n *yaml.Node, path string, severity Severity, message, suggestion string) {
+	v.issues = append(v.issues, Issue{
+		Line:       n.Line,
+		Column:     n.Column,
+		Path:       path,
+		Severity:   severity,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+func (v *validator) checkVersion(top *yaml.Node) {
+	for i := 0; i+1 < len(top.Content); i += 2 {
+		if top.Content[i].Value != "version" {
+			continue
+		}
+		value := top.Content[i+1]
+		if value.Value != "2" {
+			v.add(value, "version", SeverityError, fmt.Sprintf("unsupported config version %q", value.Value), "set version: 2 for GoReleaser v2")
+		}
+		return
+	}
+	v.add(top, "version", SeverityWarning, "missing version", "add version: 2 at the top of the file")
+}
+
+func (v *validator) node(n *yaml.Node, s *Schema, path string) {
+	s = s.resolve()
+	if s == nil {
+		return
+	}
+
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+
+	// Empty values decode to the zero value
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+
+	switch n.Kind {
+	case yaml.MappingNode:
+		if s.allows("object") {
+			v.mapping(n, s, path)
+			return
+		}
+		if s.allows("map") {
+			if s.Values != nil {
+				for i := 0; i+1 < len(n.Content); i += 2 {
+					v.node(n.Content[i+1], s.Values, joinPath(path, n.Content[i].Value))
+				}
+			}
+			return
+		}
+		v.add(n, path, SeverityError, fmt.Sprintf("%s must be %s, got a mapping", displayPath(path), describeTypes(s)), "")
+
+	case yaml.SequenceNode:
+		if !s.allows("list") {
+			v.add(n, path, SeverityError, fmt.Sprintf("%s must be %s, got a list", displayPath(path), describeTypes(s)), "")
+			return
+		}
+		if s.Items != nil {
+			for i, item := range n.Content {
+				v.node(item, s.Items, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+
+	case yaml.ScalarNode:
+		v.scalar(n, s, path)
+	}
+}
+
+func (v *validator) mapping(n *yaml.Node, s *Schema, path string) {
+	if s.Fields == nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		keyNode := n.Content[i]
+		valueNode := n.Content[i+1]
+		key := keyNode.Value
+		keyPath := joinPath(path, key)
+
+		// YAML merge keys pull fields from an anchor into this mapping
+		if key == "<<" {
+			merged := valueNode
+			if merged.Kind == yaml.AliasNode && merged.Alias != nil {
+				merged = merged.Alias
+			}
+			v.node(merged, s, path)
+			continue
+		}
+
+		if seen[key] {
+			v.add(keyNode, keyPath, SeverityError, fmt.Sprintf("duplicate key %q", key), "")
+		}
+		seen[key] = true
+
+		field, ok := s.Fields[key]
+		if !ok {
+			suggestion := ""
+			if closest := closestKey(key, s.fieldNames()); closest != "" {
+				suggestion = fmt.Sprintf("did you mean %q?", closest)
+			}
+			// Newer or Pro keys aren't in the schema, goreleaser check has the final word
+			v.add(keyNode, keyPath, SeverityWarning, fmt.Sprintf("unknown key %q in %s", key, displayPath(path)), suggestion)
+			continue
+		}
+
+		if field.Removed != "" {
+			suggestion := ""
+			if field.Replacement != "" {
+				suggestion = fmt.Sprintf("rewrite %s to %s", key, field.Replacement)
+			}
+			v.add(keyNode, keyPath, SeverityError, fmt.Sprintf("%s is no longer supported: %s", keyPath, field.Removed), suggestion)
+			v.issues[len(v.issues)-1].Fixable = true
+			continue
+		}
+
+		if field.Deprecated != "" {
+			suggestion := ""
+			if field.Replacement != "" {
+				suggestion = fmt.Sprintf("rewrite %s to %s", key, field.Replacement)
+			}
+			v.add(keyNode, keyPath, SeverityWarning, fmt.Sprintf("%s is deprecated: %s", keyPath, field.Deprecated), suggestion)
+			v.issues[len(v.issues)-1].Fixable = true
+		}
+
+		v.node(valueNode, field, keyPath)
+	}
+}
+
+func (v *validator) scalar(n *yaml.Node, s *Schema, path string) {
+	templated := strings.Contains(n.Value, "{{")
+
+	ok := false
+	for _, t := range s.types() {
+		switch t {
+		case "any", "scalar", "string":
+			ok = true
+		case "int":
+			ok = ok || n.Tag == "!!int" || templated
+		case "bool":
+			ok = ok || n.Tag == "!!bool"
+		}
+	}
+
+	if !ok {
+		suggestion := ""
+		if s.allows("list") && !s.allows("string") {
+			suggestion = fmt.Sprintf("wrap the value in a list: [%s]", n.Value)
+		}
+		v.add(n, path, SeverityError, fmt.Sprintf("%s must be %s, got %q", displayPath(path), describeTypes(s), n.Value), suggestion)
+		return
+	}
+
+	if len(s.Enum) > 0 && !templated {
+		for _, allowed := range s.Enum {
+			if n.Value == allowed {
+				return
+			}
+		}
+		suggestion := fmt.Sprintf("expected one of: %s", strings.Join(nonEmpty(s.Enum), ", "))
+		if closest := closestKey(n.Value, s.Enum); closest != "" {
+			suggestion = fmt.Sprintf("did you mean %q?", closest)
+		}
+		v.add(n, path, SeverityError, fmt.Sprintf("invalid value %q for %s", n.Value, displayPath(path)), suggestion)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "config"
+	}
+	return path
+}
+
+func describeTypes(s *Schema) string {
+	var names []string
+	for _, t := range s.types() {
+		switch t {
+		case "object", "map":
+			names = append(names, "a mapping")
+		case "list":
+			names = append(names, "a list")
+		case "int":
+			names = append(names, "a number")
+		case "bool":
+			names = append(names, "true or false")
+		default:
+			names = append(names, "a "+t)
+		}
+	}
+	return strings.Join(names, " or ")
+}
+
+func nonEmpty(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// closestKey returns the candidate within a small edit distance of key, if any
+func closestKey(key string, candidates []string) string {
+	best := ""
+	bestDistance := 3
+	if len(key) <= 4 {
+		bestDistance = 2
+	}
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		if d := levenshtein(key, candidate); d < bestDistance {
+			best = candidate
+			bestDistance = d
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,163 @@
+package goreleaser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateValidConfig(t *testing.T) {
+	config := `version: 2
+
+project_name: tool
+
+before:
+  hooks:
+    - go mod tidy
+    - cmd: go generate ./...
+      dir: internal
+
+builds:
+  - env:
+      - CGO_ENABLED=0
+    goos: [linux, darwin]
+    ldflags: -s -w -X main.version={{.Version}}
+
+archives:
+  - formats: [tar.gz]
+    format_overrides:
+      - goos: windows
+        formats: [zip]
+    files:
+      - README.md
+      - src: docs/*
+        dst: docs
+
+release:
+  draft: true
+  prerelease: auto
+
+changelog:
+  sort: asc
+
+chocolateys:
+  - name: tool
+
+npms:
+  - name: "@acme/tool"
+
+makeselfs:
+  - id: tool
+
+dockers_v2:
+  - images: [ghcr.io/acme/tool]
+
+includes:
+  - from_file:
+      path: ./shared.yaml
+
+variables:
+  description: A tool
+
+nightly:
+  version_template: "{{ incpatch .Version }}-nightly"
+`
+
+	issues, err := Validate([]byte(config))
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+}
+
+func TestValidateReportsIssues(t *testing.T) {
+	config := `version: 2
+
+biulds:
+  - goos: linux
+
+archives:
+  - format: zip
+    wrap_in_directory: true
+
+release:
+  draft: maybe
+
+changelog:
+  sort: ascending
+
+brews:
+  - name: tool
+`
+
+	issues, err := Validate([]byte(config))
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	tests := []struct {
+		line       int
+		column     int
+		severity   Severity
+		message    string
+		suggestion string
+	}{
+		{3, 1, SeverityWarning, `unknown key "biulds"`, `did you mean "builds"?`},
+		{7, 5, SeverityWarning, "archives[0].format is deprecated", "rewrite format to formats"},
+		{11, 10, SeverityError, "release.draft must be true or false", ""},
+		{14, 9, SeverityError, `invalid value "ascending"`, "expected one of: asc, desc"},
+		{16, 1, SeverityWarning, "brews is deprecated", "rewrite brews to homebrew_casks"},
+	}
+
+	if len(issues) != len(tests) {
+		t.Fatalf("Expected %d issues, got %d: %v", len(tests), len(issues), issues)
+	}
+
+	for i, tt := range tests {
+		got := issues[i]
+		if got.Line != tt.line || got.Column != tt.column {
+			t.Errorf("Issue %d: expected %d:%d, got %d:%d (%s)", i, tt.line, tt.column, got.Line, got.Column, got.Message)
+		}
+		if got.Severity != tt.severity {
+			t.Errorf("Issue %d: expected severity %s, got %s", i, tt.severity, got.Severity)
+		}
+		if !strings.Contains(got.Message, tt.message) {
+			t.Errorf("Issue %d: expected message containing %q, got %q", i, tt.message, got.Message)
+		}
+		if !strings.Contains(got.Suggestion, tt.suggestion) {
+			t.Errorf("Issue %d: expected suggestion containing %q, got %q", i, tt.suggestion, got.Suggestion)
+		}
+	}
+
+	if !HasErrors(issues) {
+		t.Error("Expected HasErrors to be true")
+	}
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	issues, err := Validate([]byte("version: 2\nbuilds:\n  - goos: [linux\n"))
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Severity != SeverityError || issues[0].Line == 0 {
+		t.Errorf("Expected one positioned syntax error, got %v", issues)
+	}
+}
+
+func TestValidateMissingVersion(t *testing.T) {
+	issues, _ := Validate([]byte("project_name: tool\n"))
+	if len(issues) != 1 || issues[0].Severity != SeverityWarning {
+		t.Errorf("Expected missing version warning, got %v", issues)
+	}
+}
+
+func TestValidateUnknownKeyIsNotAnError(t *testing.T) {
+	issues, err := Validate([]byte("version: 2\nsomething_new:\n  - name: tool\n"))
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if len(issues) != 1 || HasErrors(issues) {
+		t.Errorf("Expected one unknown key warning, got %v", issues)
+	}
+}
@@ -12,6 +12,7 @@ const (
 	PhaseGoReleaser
 	PhaseHomebrew
 	PhaseNPM
+	PhaseGoModule
 	PhaseComplete
 	PhaseFailed
 )
@@ -32,6 +33,8 @@ func (p ReleasePhase) String() string {
 		return "Homebrew Tap"
 	case PhaseNPM:
 		return "NPM Publish"
+	case PhaseGoModule:
+		return "Go Module Proxy"
 	case PhaseComplete:
 		return "Complete"
 	case PhaseFailed:
@@ -68,11 +71,26 @@ type ReleaseCompleteMsg struct {
 	Version      string
 	Duration     time.Duration
 	Channels     []string
+	Links        []string // "Label: url" lines for the release summary
 	TotalSteps   int
 	FailedStep   string
 	Error        error
 }
 
+// ChannelCheck is one row of the post-release verification. Skipped means the channel
+// couldn't be checked from here, not that it failed.
+type ChannelCheck struct {
+	Channel string
+	OK      bool
+	Skipped bool
+	Detail  string
+}
+
+type ReleaseVerifiedMsg struct {
+	Version string
+	Checks  []ChannelCheck
+}
+
 type ReleaseErrorMsg struct {
 	Phase        ReleasePhase
 	Error        error
@@ -14,11 +14,22 @@ type GitHubAccount struct {
 	Username string `yaml:"username"`
 	IsOrg    bool   `yaml:"is_org,omitempty"`
 	Default  bool   `yaml:"default,omitempty"`
+	Host     string `yaml:"host,omitempty"` // GitHub Enterprise Server host, github.com when empty
+}
+
+// ForgeAccount is an account on a GitLab, Gitea or Forgejo instance. Its host is how
+// distui recognises remotes on the instance.
+type ForgeAccount struct {
+	Kind     string `yaml:"kind"` // gitlab, gitea or forgejo
+	Host     string `yaml:"host"`
+	APIURL   string `yaml:"api_url,omitempty"` // https://<host>/api/v1, /api/v4 on GitLab, when empty
+	Username string `yaml:"username,omitempty"`
 }
 
 type UserConfig struct {
 	GitHubUsername    string          `yaml:"github_username"` // Primary account (backwards compat)
 	GitHubAccounts    []GitHubAccount `yaml:"github_accounts,omitempty"` // Multiple accounts/orgs
+	ForgeAccounts     []ForgeAccount  `yaml:"forge_accounts,omitempty"`  // Accounts on other forges
 	DefaultHomebrewTap string         `yaml:"default_homebrew_tap,omitempty"`
 	NPMScope          string         `yaml:"npm_scope,omitempty"`
 }
@@ -47,6 +58,7 @@ type ProjectConfig struct {
 	History                 *ReleaseHistory  `yaml:"history,omitempty"`
 	FirstTimeSetupCompleted bool             `yaml:"first_time_setup_completed,omitempty"`
 	CustomFilesMode         bool             `yaml:"custom_files_mode,omitempty"`
+	MergeCustomGoReleaser   bool             `yaml:"merge_custom_goreleaser,omitempty"` // Manage distui sections inside a custom .goreleaser.yaml
 }
 
 type ProjectInfo struct {
@@ -57,12 +69,15 @@ type ProjectInfo struct {
 	Repository   *RepositoryInfo `yaml:"repository"`
 	Module       *ModuleInfo     `yaml:"module"`
 	Binary       *BinaryInfo     `yaml:"binary,omitempty"`
+	License      string          `yaml:"license,omitempty"` // SPDX identifier from the LICENSE file
 }
 
 type RepositoryInfo struct {
 	Owner         string `yaml:"owner"`
 	Name          string `yaml:"name"`
 	DefaultBranch string `yaml:"default_branch,omitempty"`
+	Host          string `yaml:"host,omitempty"`  // github.com when empty
+	Forge         string `yaml:"forge,omitempty"` // github or gitlab, github when empty
 }
 
 type ModuleInfo struct {
@@ -79,6 +94,8 @@ type ProjectSettings struct {
 	Distributions Distributions      `yaml:"distributions"`
 	Build         *BuildSettings     `yaml:"build,omitempty"`
 	Release       *ReleaseSettings   `yaml:"release,omitempty"`
+	Archive       *ArchiveSettings   `yaml:"archive,omitempty"`
+	Completions   *CompletionSettings `yaml:"completions,omitempty"`
 	SmartCommit   *SmartCommitPrefs  `yaml:"smart_commit,omitempty"`
 	CICD          *CICDSettings      `yaml:"ci_cd,omitempty"`
 }
@@ -88,6 +105,12 @@ type Distributions struct {
 	Homebrew      *HomebrewConfig      `yaml:"homebrew,omitempty"`
 	NPM           *NPMConfig           `yaml:"npm,omitempty"`
 	GoModule      *GoModuleConfig      `yaml:"go_module,omitempty"`
+	Scoop         *ScoopConfig         `yaml:"scoop,omitempty"`
+	Winget        *WingetConfig        `yaml:"winget,omitempty"`
+	AUR           *AURConfig           `yaml:"aur,omitempty"`
+	NFPM          *NFPMConfig          `yaml:"nfpm,omitempty"`
+	Docker        *DockerConfig        `yaml:"docker,omitempty"`
+	Nix           *NixConfig           `yaml:"nix,omitempty"`
 }
 
 type GitHubReleaseConfig struct {
@@ -102,13 +125,99 @@ type HomebrewConfig struct {
 	TapPath     string `yaml:"tap_path,omitempty"`
 	FormulaName string `yaml:"formula_name,omitempty"`
 	FormulaPath string `yaml:"formula_path,omitempty"`
+	Package     string `yaml:"package,omitempty"` // cask or formula, empty is formula
+	Description string `yaml:"description,omitempty"`
+	License     string `yaml:"license,omitempty"` // SPDX identifier, omitted when unknown
+	// MigrateFormula is set once the user agreed to remove the formula the cask replaces
+	MigrateFormula bool `yaml:"migrate_formula,omitempty"`
 }
 
 type NPMConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	PackageName     string        `yaml:"package_name,omitempty"`
+	Registry        string        `yaml:"registry,omitempty"`
+	Access          string        `yaml:"access,omitempty"`
+	Mode            string        `yaml:"mode,omitempty"`             // postinstall (golang-npm download, default) or native (per-platform packages)
+	Publish         string        `yaml:"publish,omitempty"`          // commit (bump after the tag, default), pretag or staged
+	Tag             string        `yaml:"tag,omitempty"`              // dist-tag, latest when empty
+	Provenance      bool          `yaml:"provenance,omitempty"`       // Only applies on npmjs from GitHub Actions
+	TokenEnv        string        `yaml:"token_env,omitempty"`        // Env var with the Registry token, ~/.npmrc is used when empty
+	ExtraRegistries []NPMRegistry `yaml:"extra_registries,omitempty"` // Also publish to these, e.g. a private Verdaccio
+}
+
+type NPMRegistry struct {
+	URL      string `yaml:"url"`
+	TokenEnv string `yaml:"token_env,omitempty"`
+}
+
+type ScoopConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	BucketRepo   string `yaml:"bucket_repo,omitempty"`
+	BucketPath   string `yaml:"bucket_path,omitempty"`
+	ManifestName string `yaml:"manifest_name,omitempty"`
+}
+
+type WingetConfig struct {
+	Enabled           bool   `yaml:"enabled"`
+	PackageIdentifier string `yaml:"package_identifier,omitempty"` // Publisher.Package
+	Publisher         string `yaml:"publisher,omitempty"`
+	License           string `yaml:"license,omitempty"`
+	ShortDescription  string `yaml:"short_description,omitempty"`
+	ForkRepo          string `yaml:"fork_repo,omitempty"` // owner/winget-pkgs fork the manifest PR is opened from
+}
+
+type AURConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	PackageName    string `yaml:"package_name,omitempty"` // Usually <binary>-bin
+	Maintainer     string `yaml:"maintainer,omitempty"`   // "Name <email>"
+	License        string `yaml:"license,omitempty"`
+	PrivateKeyPath string `yaml:"private_key_path,omitempty"` // SSH key registered with the AUR account
+}
+
+// NFPMConfig builds deb/rpm/apk/archlinux packages with GoReleaser's nfpms
+type NFPMConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	Formats      []string      `yaml:"formats,omitempty"` // deb, rpm, apk, archlinux
+	Maintainer   string        `yaml:"maintainer,omitempty"`
+	Vendor       string        `yaml:"vendor,omitempty"`
+	License      string        `yaml:"license,omitempty"`
+	Dependencies []string      `yaml:"dependencies,omitempty"`
+	ConfigFiles  []NFPMContent `yaml:"config_files,omitempty"`  // Kept on upgrade
+	SystemdUnits []string      `yaml:"systemd_units,omitempty"` // Unit files in the repo
+	Scripts      NFPMScripts   `yaml:"scripts,omitempty"`
+}
+
+type NFPMContent struct {
+	Src string `yaml:"src"`
+	Dst string `yaml:"dst"`
+}
+
+// NFPMScripts are paths to install scripts in the repo
+type NFPMScripts struct {
+	PreInstall  string `yaml:"preinstall,omitempty"`
+	PostInstall string `yaml:"postinstall,omitempty"`
+	PreRemove   string `yaml:"preremove,omitempty"`
+	PostRemove  string `yaml:"postremove,omitempty"`
+}
+
+// DockerConfig publishes multi-arch container images with GoReleaser's dockers
+type DockerConfig struct {
+	Enabled    bool     `yaml:"enabled"`
+	Registry   string   `yaml:"registry,omitempty"`   // e.g. ghcr.io
+	Image      string   `yaml:"image,omitempty"`      // owner/name, without the registry
+	Dockerfile string   `yaml:"dockerfile,omitempty"` // Relative to the project
+	BaseImage  string   `yaml:"base_image,omitempty"` // distroless or scratch, for the generated Dockerfile
+	Platforms  []string `yaml:"platforms,omitempty"`  // linux/amd64, linux/arm64
+}
+
+// NixConfig pushes a derivation to a NUR repository or generates a flake.nix
+type NixConfig struct {
 	Enabled     bool   `yaml:"enabled"`
-	PackageName string `yaml:"package_name,omitempty"`
-	Registry    string `yaml:"registry,omitempty"`
-	Access      string `yaml:"access,omitempty"`
+	Mode        string `yaml:"mode,omitempty"`       // nur or flake
+	Repository  string `yaml:"repository,omitempty"` // NUR repository, owner/nur-packages
+	Path        string `yaml:"path,omitempty"`       // Derivation path in the NUR repository
+	License     string `yaml:"license,omitempty"`    // nixpkgs license attribute, e.g. mit
+	Description string `yaml:"description,omitempty"`
 }
 
 type GoModuleConfig struct {
@@ -121,6 +230,22 @@ type BuildSettings struct {
 	TestCommand      string `yaml:"test_command,omitempty"`
 }
 
+// ArchiveSettings controls what goes into the release archives and how they are named
+type ArchiveSettings struct {
+	NameTemplate    string            `yaml:"name_template,omitempty"` // GoReleaser template, empty for the default
+	Files           []string          `yaml:"files,omitempty"`         // Extra files/globs shipped next to the binary
+	WrapInDirectory bool              `yaml:"wrap_in_directory,omitempty"`
+	Formats         map[string]string `yaml:"formats,omitempty"` // goos -> tar.gz, zip or binary
+}
+
+// CompletionSettings ships shell completions and man pages generated by the CLI itself
+type CompletionSettings struct {
+	Enabled   bool   `yaml:"enabled"`
+	ManPages  bool   `yaml:"man_pages,omitempty"`
+	Framework string `yaml:"framework,omitempty"` // cobra or urfave-cli, as detected from go.mod
+	Main      string `yaml:"main,omitempty"`      // main package the hooks run, the project root if empty
+}
+
 type ReleaseSettings struct {
 	SkipTests         bool `yaml:"skip_tests"`
 	CreateDraft       bool `yaml:"create_draft"`
@@ -0,0 +1,70 @@
+package pkginspect
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var apkScripts = []string{".pre-install", ".post-install", ".pre-deinstall", ".post-deinstall", ".pre-upgrade", ".post-upgrade"}
+
+// inspectAPK reads the concatenated gzip streams (signature, control, data) as one tar
+func inspectAPK(path string) (*Info, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("not an apk package: %w", err)
+	}
+	defer gz.Close()
+
+	info := &Info{Format: "apk"}
+	foundInfo := false
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case header.Name == ".PKGINFO":
+			foundInfo = true
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			fields := parseKeyValues(string(content), " = ")
+			info.Name = first(fields["pkgname"])
+			info.Version = first(fields["pkgver"])
+			info.Arch = first(fields["arch"])
+			info.Maintainer = first(fields["maintainer"])
+			for _, dep := range fields["depend"] {
+				info.Depends = append(info.Depends, dependencyName(dep))
+			}
+		case strings.HasPrefix(header.Name, "."):
+			for _, script := range apkScripts {
+				if header.Name == script {
+					info.Scripts = append(info.Scripts, strings.TrimPrefix(script, "."))
+				}
+			}
+		case header.Typeflag != tar.TypeDir:
+			info.Files = append(info.Files, installedPath(header.Name))
+		}
+	}
+
+	if !foundInfo {
+		return nil, fmt.Errorf("no .PKGINFO")
+	}
+	return info, nil
+}
@@ -0,0 +1,44 @@
+package pkginspect
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// inspectArch shells out to tar, the standard library has no zstd
+func inspectArch(path string) (*Info, error) {
+	pkginfo, err := exec.Command("tar", "--zstd", "-xOf", path, ".PKGINFO").Output()
+	if err != nil {
+		return nil, fmt.Errorf("reading .PKGINFO needs tar with zstd support: %w", err)
+	}
+
+	info := &Info{Format: "archlinux"}
+	fields := parseKeyValues(string(pkginfo), " = ")
+	info.Name = first(fields["pkgname"])
+	info.Version = first(fields["pkgver"])
+	info.Arch = first(fields["arch"])
+	info.Maintainer = first(fields["packager"])
+	for _, dep := range fields["depend"] {
+		info.Depends = append(info.Depends, dependencyName(dep))
+	}
+	for _, backup := range fields["backup"] {
+		info.ConfigFiles = append(info.ConfigFiles, installedPath(backup))
+	}
+
+	listing, err := exec.Command("tar", "--zstd", "-tf", path).Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing package: %w", err)
+	}
+	for _, name := range strings.Split(string(listing), "\n") {
+		switch {
+		case name == "" || strings.HasSuffix(name, "/"):
+		case name == ".INSTALL":
+			info.Scripts = append(info.Scripts, "install")
+		case strings.HasPrefix(name, "."):
+		default:
+			info.Files = append(info.Files, installedPath(name))
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,147 @@
+package pkginspect
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var debScripts = []string{"preinst", "postinst", "prerm", "postrm"}
+
+// inspectDeb reads the ar archive, control.tar.gz for metadata and data.tar.gz for files
+func inspectDeb(path string) (*Info, error) {
+	members, err := readAr(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{Format: "deb"}
+	foundControl := false
+	for name, data := range members {
+		switch {
+		case strings.HasPrefix(name, "control.tar"):
+			foundControl = true
+			if err := readDebControl(info, name, data); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(name, "data.tar"):
+			if err := walkTar(name, data, func(header *tar.Header, _ io.Reader) error {
+				if header.Typeflag != tar.TypeDir {
+					info.Files = append(info.Files, installedPath(header.Name))
+				}
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !foundControl {
+		return nil, fmt.Errorf("no control archive")
+	}
+	return info, nil
+}
+
+func readDebControl(info *Info, name string, data []byte) error {
+	return walkTar(name, data, func(header *tar.Header, r io.Reader) error {
+		entry := strings.TrimPrefix(header.Name, "./")
+		switch {
+		case entry == "control":
+			content, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			fields := parseKeyValues(string(content), ": ")
+			info.Name = first(fields["Package"])
+			info.Version = first(fields["Version"])
+			info.Arch = first(fields["Architecture"])
+			info.Maintainer = first(fields["Maintainer"])
+			for _, dep := range strings.Split(first(fields["Depends"]), ",") {
+				if dep = dependencyName(dep); dep != "" {
+					info.Depends = append(info.Depends, dep)
+				}
+			}
+		case entry == "conffiles":
+			content, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			for _, line := range strings.Split(string(content), "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					info.ConfigFiles = append(info.ConfigFiles, line)
+				}
+			}
+		default:
+			for _, script := range debScripts {
+				if entry == script {
+					info.Scripts = append(info.Scripts, script)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// walkTar calls fn for every entry of a (gzip compressed) tar member
+func walkTar(name string, data []byte, fn func(*tar.Header, io.Reader) error) error {
+	var r io.Reader = bytes.NewReader(data)
+	switch {
+	case strings.HasSuffix(name, ".gz"):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+		defer gz.Close()
+		r = gz
+	case strings.HasSuffix(name, ".tar"):
+	default:
+		return fmt.Errorf("%s uses an unsupported compression", name)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+		if err := fn(header, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// readAr returns the members of an ar archive by name
+func readAr(path string) (map[string][]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, []byte("!<arch>\n")) {
+		return nil, fmt.Errorf("not an ar archive")
+	}
+
+	members := map[string][]byte{}
+	offset := 8
+	for offset+60 <= len(data) {
+		header := data[offset : offset+60]
+		name := strings.TrimSuffix(strings.TrimSpace(string(header[0:16])), "/")
+		size, err := strconv.Atoi(strings.TrimSpace(string(header[48:58])))
+		if err != nil {
+			return nil, fmt.Errorf("bad ar header for %s", name)
+		}
+		start := offset + 60
+		if start+size > len(data) {
+			return nil, fmt.Errorf("truncated ar member %s", name)
+		}
+		members[name] = data[start : start+size]
+		offset = start + size + size%2
+	}
+	return members, nil
+}
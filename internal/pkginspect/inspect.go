@@ -0,0 +1,126 @@
+// Package pkginspect reads the metadata of deb, rpm, apk and archlinux packages
+// without needing the distro tooling installed.
+package pkginspect
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Info is the metadata of a built package
+type Info struct {
+	Path        string
+	Format      string // deb, rpm, apk, archlinux
+	Name        string
+	Version     string
+	Arch        string
+	Maintainer  string
+	Depends     []string
+	Files       []string // Installed paths, absolute
+	ConfigFiles []string // Paths kept on upgrade
+	Scripts     []string // Install scripts in the package's own naming, e.g. postinst
+}
+
+// FormatOf returns the package format for a file name, or ""
+func FormatOf(name string) string {
+	switch {
+	case strings.HasSuffix(name, ".deb"):
+		return "deb"
+	case strings.HasSuffix(name, ".rpm"):
+		return "rpm"
+	case strings.HasSuffix(name, ".apk"):
+		return "apk"
+	case strings.HasSuffix(name, ".pkg.tar.zst"):
+		return "archlinux"
+	}
+	return ""
+}
+
+// Inspect reads the package at path
+func Inspect(path string) (*Info, error) {
+	var info *Info
+	var err error
+	switch FormatOf(path) {
+	case "deb":
+		info, err = inspectDeb(path)
+	case "rpm":
+		info, err = inspectRPM(path)
+	case "apk":
+		info, err = inspectAPK(path)
+	case "archlinux":
+		info, err = inspectArch(path)
+	default:
+		return nil, fmt.Errorf("%s is not a linux package", filepath.Base(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("inspecting %s: %w", filepath.Base(path), err)
+	}
+	info.Path = path
+	return info, nil
+}
+
+// HasFile reports whether the package installs path
+func (i *Info) HasFile(path string) bool {
+	for _, file := range i.Files {
+		if file == path {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConfigFile reports whether path is kept on upgrade
+func (i *Info) IsConfigFile(path string) bool {
+	for _, file := range i.ConfigFiles {
+		if file == path {
+			return true
+		}
+	}
+	return false
+}
+
+// DependsOn reports whether the package declares a dependency on name
+func (i *Info) DependsOn(name string) bool {
+	for _, dep := range i.Depends {
+		if dep == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseKeyValues reads "key = value" (separator " = ") or "Key: value" (separator ": ") lines
+func parseKeyValues(data, separator string) map[string][]string {
+	values := map[string][]string{}
+	for _, line := range strings.Split(data, "\n") {
+		key, value, ok := strings.Cut(line, separator)
+		if !ok || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		values[key] = append(values[key], strings.TrimSpace(value))
+	}
+	return values
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// dependencyName strips version constraints, "git (>= 2.0)" and "git>=2.0" become "git"
+func dependencyName(dep string) string {
+	dep = strings.TrimSpace(dep)
+	if idx := strings.IndexAny(dep, " (<>="); idx > 0 {
+		dep = dep[:idx]
+	}
+	return dep
+}
+
+// installedPath turns an archive entry name into an absolute path
+func installedPath(name string) string {
+	return "/" + strings.TrimPrefix(strings.TrimPrefix(name, "./"), "/")
+}
@@ -0,0 +1,198 @@
+package pkginspect
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type tarFile struct {
+	name    string
+	content string
+}
+
+func gzipTar(t *testing.T, files []tarFile, terminate bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, file := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if terminate {
+		tw.Close()
+	} else {
+		tw.Flush()
+	}
+	gz.Close()
+	return buf.Bytes()
+}
+
+func writeDeb(t *testing.T, path string) {
+	t.Helper()
+	control := gzipTar(t, []tarFile{
+		{"./control", "Package: tool\nVersion: 1.2.3\nArchitecture: amd64\nMaintainer: Jane Doe <jane@example.com>\nDepends: git (>= 2.0), ca-certificates\nDescription: tool\n long text\n"},
+		{"./conffiles", "/etc/tool/tool.yaml\n"},
+		{"./postinst", "#!/bin/sh\n"},
+	}, true)
+	data := gzipTar(t, []tarFile{
+		{"./usr/bin/tool", "binary"},
+		{"./etc/tool/tool.yaml", "key: value"},
+	}, true)
+
+	var buf bytes.Buffer
+	buf.WriteString("!<arch>\n")
+	for _, member := range []struct {
+		name string
+		data []byte
+	}{{"debian-binary", []byte("2.0\n")}, {"control.tar.gz", control}, {"data.tar.gz", data}} {
+		fmt.Fprintf(&buf, "%-16s%-12d%-6d%-6d%-8s%-10d`\n", member.name, 0, 0, 0, "100644", len(member.data))
+		buf.Write(member.data)
+		if len(member.data)%2 == 1 {
+			buf.WriteByte('\n')
+		}
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeAPK(t *testing.T, path string) {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.Write(gzipTar(t, []tarFile{
+		{".PKGINFO", "# Generated\npkgname = tool\npkgver = 1.2.3-r0\narch = x86_64\nmaintainer = Jane Doe <jane@example.com>\ndepend = git>=2.0\n"},
+		{".post-install", "#!/bin/sh\n"},
+	}, false))
+	buf.Write(gzipTar(t, []tarFile{{"usr/bin/tool", "binary"}}, true))
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type rpmEntry struct {
+	tag     int
+	kind    uint32
+	strings []string
+	ints    []int32
+}
+
+func rpmHeaderBytes(entries []rpmEntry) []byte {
+	var index, store bytes.Buffer
+	for _, entry := range entries {
+		count := len(entry.strings)
+		if entry.kind == rpmTypeInt32 {
+			for store.Len()%4 != 0 {
+				store.WriteByte(0)
+			}
+			count = len(entry.ints)
+		}
+		binary.Write(&index, binary.BigEndian, []uint32{uint32(entry.tag), entry.kind, uint32(store.Len()), uint32(count)})
+		for _, s := range entry.strings {
+			store.WriteString(s)
+			store.WriteByte(0)
+		}
+		for _, i := range entry.ints {
+			binary.Write(&store, binary.BigEndian, i)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.Write(rpmHeaderMagic)
+	buf.Write([]byte{0, 0, 0, 0})
+	binary.Write(&buf, binary.BigEndian, []uint32{uint32(len(entries)), uint32(store.Len())})
+	buf.Write(index.Bytes())
+	buf.Write(store.Bytes())
+	return buf.Bytes()
+}
+
+func writeRPM(t *testing.T, path string) {
+	t.Helper()
+	var buf bytes.Buffer
+	lead := make([]byte, 96)
+	copy(lead, []byte{0xed, 0xab, 0xee, 0xdb})
+	buf.Write(lead)
+	buf.Write(rpmHeaderBytes([]rpmEntry{{tag: 1004, kind: rpmTypeString, strings: []string{"x"}}}))
+	for buf.Len()%8 != 0 {
+		buf.WriteByte(0)
+	}
+	buf.Write(rpmHeaderBytes([]rpmEntry{
+		{tag: rpmTagName, kind: rpmTypeString, strings: []string{"tool"}},
+		{tag: rpmTagVersion, kind: rpmTypeString, strings: []string{"1.2.3"}},
+		{tag: rpmTagRelease, kind: rpmTypeString, strings: []string{"1"}},
+		{tag: rpmTagPackager, kind: rpmTypeString, strings: []string{"Jane Doe <jane@example.com>"}},
+		{tag: rpmTagArch, kind: rpmTypeString, strings: []string{"x86_64"}},
+		{tag: rpmTagPostIn, kind: rpmTypeString, strings: []string{"echo hi"}},
+		{tag: rpmTagFileFlags, kind: rpmTypeInt32, ints: []int32{0, rpmFileConfig}},
+		{tag: rpmTagRequireName, kind: rpmTypeStringArray, strings: []string{"/bin/sh", "git", "rpmlib(CompressedFileNames)"}},
+		{tag: rpmTagDirIndexes, kind: rpmTypeInt32, ints: []int32{0, 1}},
+		{tag: rpmTagBaseNames, kind: rpmTypeStringArray, strings: []string{"tool", "tool.yaml"}},
+		{tag: rpmTagDirNames, kind: rpmTypeStringArray, strings: []string{"/usr/bin/", "/etc/tool/"}},
+	}))
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		file  string
+		write func(*testing.T, string)
+		want  Info
+	}{
+		{"tool_1.2.3_amd64.deb", writeDeb, Info{
+			Format: "deb", Name: "tool", Version: "1.2.3", Arch: "amd64", Maintainer: "Jane Doe <jane@example.com>",
+			Depends: []string{"git", "ca-certificates"}, Files: []string{"/usr/bin/tool", "/etc/tool/tool.yaml"},
+			ConfigFiles: []string{"/etc/tool/tool.yaml"}, Scripts: []string{"postinst"},
+		}},
+		{"tool_1.2.3_x86_64.apk", writeAPK, Info{
+			Format: "apk", Name: "tool", Version: "1.2.3-r0", Arch: "x86_64", Maintainer: "Jane Doe <jane@example.com>",
+			Depends: []string{"git"}, Files: []string{"/usr/bin/tool"}, Scripts: []string{"post-install"},
+		}},
+		{"tool-1.2.3-1.x86_64.rpm", writeRPM, Info{
+			Format: "rpm", Name: "tool", Version: "1.2.3-1", Arch: "x86_64", Maintainer: "Jane Doe <jane@example.com>",
+			Depends: []string{"git"}, Files: []string{"/usr/bin/tool", "/etc/tool/tool.yaml"},
+			ConfigFiles: []string{"/etc/tool/tool.yaml"}, Scripts: []string{"postin"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			tt.write(t, path)
+
+			info, err := Inspect(path)
+			if err != nil {
+				t.Fatalf("Inspect failed: %v", err)
+			}
+			tt.want.Path = path
+			if !reflect.DeepEqual(*info, tt.want) {
+				t.Errorf("Inspect() = %+v\nwant %+v", *info, tt.want)
+			}
+		})
+	}
+}
+
+func TestInspectRejectsOtherFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tool.deb")
+	os.WriteFile(path, []byte("not a package"), 0644)
+	if _, err := Inspect(path); err == nil {
+		t.Error("Expected an error for a file that isn't an ar archive")
+	}
+	if _, err := Inspect("checksums.txt"); err == nil {
+		t.Error("Expected an error for a non-package file name")
+	}
+}
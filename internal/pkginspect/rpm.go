@@ -0,0 +1,160 @@
+package pkginspect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// RPM header tags we read
+const (
+	rpmTagName        = 1000
+	rpmTagVersion     = 1001
+	rpmTagRelease     = 1002
+	rpmTagPackager    = 1015
+	rpmTagArch        = 1022
+	rpmTagPreIn       = 1023
+	rpmTagPostIn      = 1024
+	rpmTagPreUn       = 1025
+	rpmTagPostUn      = 1026
+	rpmTagFileFlags   = 1037
+	rpmTagRequireName = 1049
+	rpmTagDirIndexes  = 1116
+	rpmTagBaseNames   = 1117
+	rpmTagDirNames    = 1118
+
+	rpmFileConfig = 1 << 0
+)
+
+const (
+	rpmTypeInt32       = 4
+	rpmTypeString      = 6
+	rpmTypeStringArray = 8
+	rpmTypeI18NString  = 9
+)
+
+var rpmHeaderMagic = []byte{0x8e, 0xad, 0xe8, 0x01}
+
+type rpmHeader struct {
+	strings map[int][]string
+	ints    map[int][]int32
+}
+
+// inspectRPM skips the lead and signature header and reads the main header
+func inspectRPM(path string) (*Info, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 96 || !bytes.Equal(data[0:4], []byte{0xed, 0xab, 0xee, 0xdb}) {
+		return nil, fmt.Errorf("not an rpm package")
+	}
+
+	_, next, err := readRPMHeader(data, 96)
+	if err != nil {
+		return nil, fmt.Errorf("signature header: %w", err)
+	}
+	// The signature header is padded to 8 bytes
+	if next%8 != 0 {
+		next += 8 - next%8
+	}
+	header, _, err := readRPMHeader(data, next)
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	info := &Info{
+		Format:     "rpm",
+		Name:       first(header.strings[rpmTagName]),
+		Arch:       first(header.strings[rpmTagArch]),
+		Maintainer: first(header.strings[rpmTagPackager]),
+	}
+	info.Version = first(header.strings[rpmTagVersion])
+	if release := first(header.strings[rpmTagRelease]); release != "" {
+		info.Version += "-" + release
+	}
+
+	for _, dep := range header.strings[rpmTagRequireName] {
+		// rpmlib() and /bin/sh requirements are added by the packager itself
+		if strings.HasPrefix(dep, "rpmlib(") || strings.HasPrefix(dep, "/") {
+			continue
+		}
+		info.Depends = append(info.Depends, dep)
+	}
+
+	dirs := header.strings[rpmTagDirNames]
+	indexes := header.ints[rpmTagDirIndexes]
+	flags := header.ints[rpmTagFileFlags]
+	for i, base := range header.strings[rpmTagBaseNames] {
+		if i >= len(indexes) || int(indexes[i]) >= len(dirs) {
+			break
+		}
+		file := dirs[indexes[i]] + base
+		info.Files = append(info.Files, file)
+		if i < len(flags) && flags[i]&rpmFileConfig != 0 {
+			info.ConfigFiles = append(info.ConfigFiles, file)
+		}
+	}
+
+	for _, script := range []struct {
+		tag  int
+		name string
+	}{{rpmTagPreIn, "prein"}, {rpmTagPostIn, "postin"}, {rpmTagPreUn, "preun"}, {rpmTagPostUn, "postun"}} {
+		if len(header.strings[script.tag]) > 0 {
+			info.Scripts = append(info.Scripts, script.name)
+		}
+	}
+	return info, nil
+}
+
+// readRPMHeader parses the header structure at offset and returns the offset after it
+func readRPMHeader(data []byte, offset int) (*rpmHeader, int, error) {
+	if offset+16 > len(data) || !bytes.Equal(data[offset:offset+4], rpmHeaderMagic) {
+		return nil, 0, fmt.Errorf("bad header magic")
+	}
+	count := int(binary.BigEndian.Uint32(data[offset+8:]))
+	size := int(binary.BigEndian.Uint32(data[offset+12:]))
+	index := offset + 16
+	store := index + count*16
+	if store+size > len(data) {
+		return nil, 0, fmt.Errorf("truncated header")
+	}
+
+	header := &rpmHeader{strings: map[int][]string{}, ints: map[int][]int32{}}
+	for i := 0; i < count; i++ {
+		entry := data[index+i*16:]
+		tag := int(binary.BigEndian.Uint32(entry[0:]))
+		kind := binary.BigEndian.Uint32(entry[4:])
+		start := int(binary.BigEndian.Uint32(entry[8:]))
+		n := int(binary.BigEndian.Uint32(entry[12:]))
+		if start > size {
+			return nil, 0, fmt.Errorf("tag %d points outside the header", tag)
+		}
+		value := data[store+start : store+size]
+
+		switch kind {
+		case rpmTypeString, rpmTypeStringArray, rpmTypeI18NString:
+			if kind == rpmTypeString {
+				n = 1
+			}
+			for j := 0; j < n; j++ {
+				end := bytes.IndexByte(value, 0)
+				if end < 0 {
+					return nil, 0, fmt.Errorf("unterminated string in tag %d", tag)
+				}
+				header.strings[tag] = append(header.strings[tag], string(value[:end]))
+				value = value[end+1:]
+			}
+		case rpmTypeInt32:
+			if len(value) < n*4 {
+				return nil, 0, fmt.Errorf("truncated tag %d", tag)
+			}
+			for j := 0; j < n; j++ {
+				header.ints[tag] = append(header.ints[tag], int32(binary.BigEndian.Uint32(value[j*4:])))
+			}
+		}
+	}
+	return header, store + size, nil
+}
@@ -0,0 +1,97 @@
+package taps
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultTapName is the repository brew tap owner/tap resolves to
+const DefaultTapName = "homebrew-tap"
+
+// WriteLayout writes the standard tap layout into dir: a README with install
+// instructions and empty Formula and Casks directories
+func WriteLayout(dir, owner string) error {
+	for _, sub := range []string{"Formula", "Casks"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", sub, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, sub, ".gitkeep"), nil, 0644); err != nil {
+			return fmt.Errorf("creating %s/.gitkeep: %w", sub, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(Readme(owner)), 0644); err != nil {
+		return fmt.Errorf("writing README.md: %w", err)
+	}
+	return nil
+}
+
+// Readme is the README of a new tap for owner
+func Readme(owner string) string {
+	tap := owner + "/tap"
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# %s\n\n", tap))
+	b.WriteString(fmt.Sprintf("Homebrew tap for %s's tools.\n\n", owner))
+	b.WriteString("## Install\n\n")
+	b.WriteString("```sh\n")
+	b.WriteString(fmt.Sprintf("brew tap %s\n", tap))
+	b.WriteString(fmt.Sprintf("brew install --cask %s/<cask>\n", tap))
+	b.WriteString(fmt.Sprintf("brew install %s/<formula>\n", tap))
+	b.WriteString("```\n\n")
+	b.WriteString("Or in one step without tapping first:\n\n")
+	b.WriteString("```sh\n")
+	b.WriteString(fmt.Sprintf("brew install %s/<name>\n", tap))
+	b.WriteString("```\n\n")
+	b.WriteString("## Layout\n\n")
+	b.WriteString("- `Casks/` casks for prebuilt binaries\n")
+	b.WriteString("- `Formula/` formulas\n\n")
+	b.WriteString("Files in this tap are published by GoReleaser when a project is released.\n")
+	b.WriteString("Change the project's `.goreleaser.yaml` rather than editing them here.\n")
+	return b.String()
+}
+
+// Bootstrap creates owner/homebrew-tap on GitHub with the standard layout,
+// cloned at parent/homebrew-tap. Returns the local path.
+func Bootstrap(owner, parent string) (string, error) {
+	if owner == "" {
+		return "", fmt.Errorf("owner required")
+	}
+	dir := filepath.Join(parent, DefaultTapName)
+	if _, err := os.Stat(dir); err == nil {
+		return "", fmt.Errorf("%s already exists", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating %s: %w", dir, err)
+	}
+	if err := WriteLayout(dir, owner); err != nil {
+		return "", err
+	}
+
+	run := func(name string, args ...string) error {
+		cmd := exec.Command(name, args...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("%s %s: %s", name, args[0], strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+
+	if err := run("git", "init", "-q", "-b", "main"); err != nil {
+		return "", err
+	}
+	if err := run("git", "add", "-A"); err != nil {
+		return "", err
+	}
+	if err := run("git", "commit", "-q", "-m", "Initial tap layout"); err != nil {
+		return "", err
+	}
+	repo := owner + "/" + DefaultTapName
+	if err := run("gh", "repo", "create", repo, "--public", "--source", ".", "--push",
+		"--description", "Homebrew tap"); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
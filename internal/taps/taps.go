@@ -0,0 +1,211 @@
+// Package taps manages Homebrew taps on GitHub: finding them, listing what
+// they publish and spotting formulas that lag behind their project's release.
+package taps
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"distui/internal/detection"
+)
+
+// Tap is a homebrew-* repository and the formulas and casks it publishes
+type Tap struct {
+	Repo      string // owner/homebrew-name
+	URL       string
+	LocalPath string // Local checkout, empty if there is none
+	Packages  []Package
+	Err       string // Set when the packages couldn't be listed
+}
+
+// Package is one formula or cask file in a tap
+type Package struct {
+	Name       string
+	Cask       bool
+	Path       string // Formula/name.rb or Casks/name.rb
+	Version    string
+	SourceRepo string // owner/repo the download comes from
+	Latest     string // Latest GitHub release tag of SourceRepo
+	Stale      bool
+}
+
+var (
+	versionRe  = regexp.MustCompile(`^\s*version "([^"]+)"`)
+	downloadRe = regexp.MustCompile(`github\.com/([\w.-]+/[\w.-]+)/(?:releases/download|archive/refs/tags)/([^/"]+)`)
+	homepageRe = regexp.MustCompile(`^\s*homepage "https://github\.com/([\w.-]+/[\w.-]+?)(?:\.git)?/?"`)
+)
+
+// Discover finds the taps of every owner and lists their packages. Owners
+// gh can't list are skipped, an error is only returned if none could be.
+func Discover(owners []string) ([]Tap, error) {
+	var taps []Tap
+	var lastErr error
+	listed := 0
+	latest := map[string]string{}
+
+	for _, owner := range owners {
+		found, err := detection.ListGitHubTaps(owner)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		listed++
+		for _, repo := range found {
+			tap := Tap{Repo: repo.NameWithOwner, URL: repo.URL}
+			if local := detection.FindTapCheckout(repo.NameWithOwner, ""); local != nil {
+				tap.LocalPath = local.Path
+			}
+			packages, err := ListPackages(repo.NameWithOwner)
+			if err != nil {
+				tap.Err = err.Error()
+			}
+			for i := range packages {
+				checkRelease(&packages[i], latest)
+			}
+			tap.Packages = packages
+			taps = append(taps, tap)
+		}
+	}
+
+	if listed == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	sort.Slice(taps, func(i, j int) bool { return taps[i].Repo < taps[j].Repo })
+	return taps, nil
+}
+
+// ListPackages reads the Formula and Casks directories of tap through the GitHub API
+func ListPackages(tap string) ([]Package, error) {
+	var packages []Package
+	for _, dir := range []string{"Formula", "Casks"} {
+		files, err := listDir(tap, dir)
+		if err != nil {
+			return packages, err
+		}
+		for _, file := range files {
+			content, err := ghOutput("api", fmt.Sprintf("repos/%s/contents/%s", tap, file),
+				"-H", "Accept: application/vnd.github.raw")
+			if err != nil {
+				return packages, fmt.Errorf("reading %s: %w", file, err)
+			}
+			pkg := ParsePackage(file, content)
+			packages = append(packages, pkg)
+		}
+	}
+	return packages, nil
+}
+
+func listDir(tap, dir string) ([]string, error) {
+	output, err := ghOutput("api", fmt.Sprintf("repos/%s/contents/%s", tap, dir))
+	if err != nil {
+		// A tap without the directory is fine
+		if strings.Contains(err.Error(), "Not Found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing %s/%s: %w", tap, dir, err)
+	}
+
+	var entries []struct {
+		Path string `json:"path"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(output), &entries); err != nil {
+		return nil, fmt.Errorf("parsing %s listing: %w", dir, err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.Type == "file" && strings.HasSuffix(entry.Path, ".rb") {
+			files = append(files, entry.Path)
+		}
+	}
+	return files, nil
+}
+
+// ParsePackage reads the version and source repository of the formula or cask at path
+func ParsePackage(path, content string) Package {
+	name := path[strings.LastIndex(path, "/")+1:]
+	pkg := Package{
+		Name: strings.TrimSuffix(name, ".rb"),
+		Cask: strings.HasPrefix(path, "Casks/"),
+		Path: path,
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if match := versionRe.FindStringSubmatch(line); match != nil && pkg.Version == "" {
+			pkg.Version = match[1]
+		}
+		if match := downloadRe.FindStringSubmatch(line); match != nil && pkg.SourceRepo == "" {
+			pkg.SourceRepo = match[1]
+			if pkg.Version == "" && !strings.Contains(match[2], "#{") {
+				pkg.Version = strings.TrimSuffix(strings.TrimSuffix(match[2], ".tar.gz"), ".zip")
+			}
+		}
+		if match := homepageRe.FindStringSubmatch(line); match != nil && pkg.SourceRepo == "" {
+			pkg.SourceRepo = match[1]
+		}
+	}
+	pkg.Version = strings.TrimPrefix(pkg.Version, "v")
+	return pkg
+}
+
+// IsStale reports whether version lags behind the latest release tag.
+// Versions that aren't semver are never reported stale.
+func IsStale(version, latest string) bool {
+	v, l := "v"+strings.TrimPrefix(version, "v"), "v"+strings.TrimPrefix(latest, "v")
+	if !semver.IsValid(v) || !semver.IsValid(l) {
+		return false
+	}
+	return semver.Compare(v, l) < 0
+}
+
+// LatestRelease returns the tag of the latest GitHub release of repo
+func LatestRelease(repo string) (string, error) {
+	output, err := ghOutput("api", fmt.Sprintf("repos/%s/releases/latest", repo), "--jq", ".tag_name")
+	if err != nil {
+		return "", fmt.Errorf("latest release of %s: %w", repo, err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// checkRelease fills in Latest and Stale, caching lookups per source repo
+func checkRelease(pkg *Package, cache map[string]string) {
+	if pkg.SourceRepo == "" {
+		return
+	}
+	tag, ok := cache[pkg.SourceRepo]
+	if !ok {
+		tag, _ = LatestRelease(pkg.SourceRepo)
+		cache[pkg.SourceRepo] = tag
+	}
+	pkg.Latest = tag
+	pkg.Stale = IsStale(pkg.Version, tag)
+}
+
+// StaleCount is the number of packages in the tap that lag their latest release
+func (t *Tap) StaleCount() int {
+	count := 0
+	for _, pkg := range t.Packages {
+		if pkg.Stale {
+			count++
+		}
+	}
+	return count
+}
+
+func ghOutput(args ...string) (string, error) {
+	cmd := exec.Command("gh", args...)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("%s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return string(output), nil
+}
@@ -0,0 +1,121 @@
+package taps
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"distui/internal/detection"
+)
+
+func TestParsePackage(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+		want    Package
+	}{
+		{
+			name: "goreleaser formula",
+			path: "Formula/tool.rb",
+			content: `class Tool < Formula
+  desc "A tool"
+  homepage "https://github.com/acme/tool"
+  version "1.4.0"
+
+  on_macos do
+    url "https://github.com/acme/tool/releases/download/v1.4.0/tool_Darwin_arm64.tar.gz"
+  end
+end`,
+			want: Package{Name: "tool", Path: "Formula/tool.rb", Version: "1.4.0", SourceRepo: "acme/tool"},
+		},
+		{
+			name: "cask",
+			path: "Casks/tool.rb",
+			content: `cask "tool" do
+  version "2.0.1"
+  url "https://github.com/acme/tool-src/releases/download/v#{version}/tool.tar.gz"
+  homepage "https://example.com"
+end`,
+			want: Package{Name: "tool", Cask: true, Path: "Casks/tool.rb", Version: "2.0.1", SourceRepo: "acme/tool-src"},
+		},
+		{
+			name: "source formula versioned by tag url",
+			path: "Formula/lib.rb",
+			content: `class Lib < Formula
+  url "https://github.com/acme/lib/archive/refs/tags/v0.9.2.tar.gz"
+end`,
+			want: Package{Name: "lib", Path: "Formula/lib.rb", Version: "0.9.2", SourceRepo: "acme/lib"},
+		},
+		{
+			name:    "homepage only",
+			path:    "Formula/other.rb",
+			content: "  homepage \"https://github.com/acme/other\"\n",
+			want:    Package{Name: "other", Path: "Formula/other.rb", SourceRepo: "acme/other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParsePackage(tt.path, tt.content); got != tt.want {
+				t.Errorf("ParsePackage() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	tests := []struct {
+		version, latest string
+		want            bool
+	}{
+		{"1.4.0", "v1.5.0", true},
+		{"1.5.0", "v1.5.0", false},
+		{"v2.0.0", "1.9.9", false},
+		{"1.0.0", "", false},
+		{"nightly", "v1.0.0", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsStale(tt.version, tt.latest); got != tt.want {
+			t.Errorf("IsStale(%q, %q) = %v, want %v", tt.version, tt.latest, got, tt.want)
+		}
+	}
+}
+
+func TestParseTapRepoList(t *testing.T) {
+	data := []byte(`[
+  {"name": "tool", "nameWithOwner": "acme/tool", "url": "https://github.com/acme/tool"},
+  {"name": "homebrew-tap", "nameWithOwner": "acme/homebrew-tap", "url": "https://github.com/acme/homebrew-tap"},
+  {"name": "homebrew-extras", "nameWithOwner": "acme/homebrew-extras", "url": "https://github.com/acme/homebrew-extras"}
+]`)
+	taps, err := detection.ParseTapRepoList(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(taps) != 2 || taps[0].NameWithOwner != "acme/homebrew-tap" || taps[1].Name != "homebrew-extras" {
+		t.Errorf("ParseTapRepoList() = %+v", taps)
+	}
+
+	if _, err := detection.ParseTapRepoList([]byte("not json")); err == nil {
+		t.Error("expected an error for invalid output")
+	}
+}
+
+func TestWriteLayout(t *testing.T) {
+	dir := t.TempDir()
+	if err := WriteLayout(dir, "acme"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"Formula/.gitkeep", "Casks/.gitkeep", "README.md"} {
+		if _, err := os.Stat(filepath.Join(dir, path)); err != nil {
+			t.Errorf("missing %s", path)
+		}
+	}
+	readme, _ := os.ReadFile(filepath.Join(dir, "README.md"))
+	if !strings.Contains(string(readme), "brew tap acme/tap") {
+		t.Errorf("README lacks tap instructions:\n%s", readme)
+	}
+}
@@ -0,0 +1,208 @@
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+type OpKind int
+
+const (
+	Equal OpKind = iota
+	Insert
+	Delete
+)
+
+// Line is a single line of a diff with its operation
+type Line struct {
+	Kind OpKind
+	Text string
+}
+
+// Hunk is a group of changed lines with surrounding context, in unified diff terms
+type Hunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []Line
+}
+
+// Header returns the "@@ -a,b +c,d @@" line for the hunk
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+}
+
+// HasChanges reports whether a and b differ
+func HasChanges(a, b string) bool {
+	return a != b
+}
+
+// Lines returns the full line-level edit script turning a into b.
+// Uses an LCS table, which is fine for config-file sized inputs.
+func Lines(a, b string) []Line {
+	oldLines := splitLines(a)
+	newLines := splitLines(b)
+
+	n, m := len(oldLines), len(newLines)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var result []Line
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case oldLines[i] == newLines[j]:
+			result = append(result, Line{Kind: Equal, Text: oldLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, Line{Kind: Delete, Text: oldLines[i]})
+			i++
+		default:
+			result = append(result, Line{Kind: Insert, Text: newLines[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		result = append(result, Line{Kind: Delete, Text: oldLines[i]})
+	}
+	for ; j < m; j++ {
+		result = append(result, Line{Kind: Insert, Text: newLines[j]})
+	}
+
+	return result
+}
+
+// Hunks groups the edit script into hunks with the given number of context lines
+func Hunks(a, b string, context int) []Hunk {
+	lines := Lines(a, b)
+
+	var hunks []Hunk
+	var current *Hunk
+	oldLine, newLine := 1, 1
+	lastChange := -1
+
+	for idx, line := range lines {
+		if line.Kind != Equal {
+			if current == nil || idx-lastChange > 2*context {
+				if current != nil {
+					hunks = append(hunks, trimHunk(*current, context))
+				}
+				// Start a new hunk with leading context
+				start := idx - context
+				if start < 0 {
+					start = 0
+				}
+				if lastChange >= 0 && start <= lastChange {
+					start = lastChange + 1
+				}
+				h := Hunk{OldStart: oldLine, NewStart: newLine}
+				for k := idx - 1; k >= start; k-- {
+					h.OldStart--
+					h.NewStart--
+				}
+				for k := start; k < idx; k++ {
+					h.Lines = append(h.Lines, lines[k])
+					h.OldLines++
+					h.NewLines++
+				}
+				current = &h
+			}
+			lastChange = idx
+		}
+
+		if current != nil && (line.Kind != Equal || idx-lastChange <= context) {
+			if line.Kind != Equal || idx != lastChange {
+				current.Lines = append(current.Lines, line)
+				switch line.Kind {
+				case Equal:
+					current.OldLines++
+					current.NewLines++
+				case Delete:
+					current.OldLines++
+				case Insert:
+					current.NewLines++
+				}
+			}
+		}
+
+		switch line.Kind {
+		case Equal:
+			oldLine++
+			newLine++
+		case Delete:
+			oldLine++
+		case Insert:
+			newLine++
+		}
+	}
+
+	if current != nil {
+		hunks = append(hunks, trimHunk(*current, context))
+	}
+
+	return hunks
+}
+
+// trimHunk drops trailing context beyond the requested amount
+func trimHunk(h Hunk, context int) Hunk {
+	trailing := 0
+	for k := len(h.Lines) - 1; k >= 0 && h.Lines[k].Kind == Equal; k-- {
+		trailing++
+	}
+	for trailing > context {
+		h.Lines = h.Lines[:len(h.Lines)-1]
+		h.OldLines--
+		h.NewLines--
+		trailing--
+	}
+	return h
+}
+
+// Unified renders a unified diff between a and b
+func Unified(name, a, b string) string {
+	hunks := Hunks(a, b, 3)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("--- a/" + name + "\n")
+	sb.WriteString("+++ b/" + name + "\n")
+	for _, h := range hunks {
+		sb.WriteString(h.Header() + "\n")
+		for _, line := range h.Lines {
+			switch line.Kind {
+			case Equal:
+				sb.WriteString(" " + line.Text + "\n")
+			case Delete:
+				sb.WriteString("-" + line.Text + "\n")
+			case Insert:
+				sb.WriteString("+" + line.Text + "\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
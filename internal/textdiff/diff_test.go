@@ -0,0 +1,36 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	a := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	b := "one\ntwo\nTHREE\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\n"
+
+	diff := Unified("file.txt", a, b)
+
+	for _, want := range []string{"--- a/file.txt", "+++ b/file.txt", "-three", "+THREE", "+eleven"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("Expected diff to contain %q:\n%s", want, diff)
+		}
+	}
+
+	hunks := Hunks(a, b, 3)
+	if len(hunks) != 2 {
+		t.Fatalf("Expected 2 hunks, got %d:\n%s", len(hunks), diff)
+	}
+	if hunks[0].Header() != "@@ -1,6 +1,6 @@" {
+		t.Errorf("Unexpected first hunk header %q", hunks[0].Header())
+	}
+	if hunks[1].Header() != "@@ -8,3 +8,4 @@" {
+		t.Errorf("Unexpected second hunk header %q", hunks[1].Header())
+	}
+}
+
+func TestUnifiedNoChanges(t *testing.T) {
+	if diff := Unified("x", "same\n", "same\n"); diff != "" {
+		t.Errorf("Expected empty diff, got:\n%s", diff)
+	}
+}
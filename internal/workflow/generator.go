@@ -7,15 +7,25 @@ import (
 	"path/filepath"
 	"text/template"
 
+	"distui/internal/forge"
+	"distui/internal/generator"
 	"distui/internal/models"
 )
 
 type WorkflowData struct {
-	IncludeTests bool
-	NPMEnabled   bool
+	IncludeTests   bool
+	NPMEnabled     bool
+	DockerEnabled  bool
+	DockerRegistry string
+	GHCR           bool // ghcr.io accepts the workflow's own token, on github.com runners only
+	AUREnabled     bool // the aurs section reads the SSH key, its content works too, from AUR_KEY
 }
 
 func GenerateWorkflow(config *models.ProjectConfig) (string, error) {
+	if kind := projectForge(config); kind.IsGitea() {
+		return generateForgejoWorkflow(config, kind)
+	}
+
 	tmpl, err := template.New("workflow").Parse(workflowTemplate)
 	if err != nil {
 		return "", fmt.Errorf("parsing template: %w", err)
@@ -33,6 +43,12 @@ func GenerateWorkflow(config *models.ProjectConfig) (string, error) {
 		if config.Config.Distributions.NPM != nil && config.Config.Distributions.NPM.Enabled {
 			data.NPMEnabled = true
 		}
+		if docker := config.Config.Distributions.Docker; docker != nil && docker.Enabled {
+			data.DockerEnabled = true
+			data.DockerRegistry = docker.Registry
+			data.GHCR = ghcrToken(config)
+		}
+		data.AUREnabled = aurEnabled(config)
 	}
 
 	var buf bytes.Buffer
@@ -43,26 +59,89 @@ func GenerateWorkflow(config *models.ProjectConfig) (string, error) {
 	return buf.String(), nil
 }
 
+// DefaultWorkflowPath is where the release workflow lives, relative to the project
+const DefaultWorkflowPath = ".github/workflows/release.yml"
+
+// IsEnabled reports whether the project wants a generated release workflow
+func IsEnabled(config *models.ProjectConfig) bool {
+	return config != nil && config.Config != nil && config.Config.CICD != nil &&
+		config.Config.CICD.GitHubActions != nil && config.Config.CICD.GitHubActions.Enabled
+}
+
+// WorkflowPath returns the configured workflow path, relative to the project. Gitea and
+// Forgejo projects get their own workflow directory unless another path was chosen.
+func WorkflowPath(config *models.ProjectConfig) string {
+	path := DefaultWorkflowPath
+	if config != nil && config.Config != nil && config.Config.CICD != nil &&
+		config.Config.CICD.GitHubActions != nil && config.Config.CICD.GitHubActions.WorkflowPath != "" {
+		path = config.Config.CICD.GitHubActions.WorkflowPath
+	}
+	if path == DefaultWorkflowPath {
+		switch projectForge(config) {
+		case forge.Forgejo:
+			return ".forgejo/workflows/release.yml"
+		case forge.Gitea:
+			return ".gitea/workflows/release.yml"
+		}
+	}
+	return path
+}
+
+// aurEnabled reports whether the generated config has an aurs section
+func aurEnabled(config *models.ProjectConfig) bool {
+	return config != nil && config.Config != nil && config.Config.Distributions.AUR != nil &&
+		config.Config.Distributions.AUR.Enabled
+}
+
+// ghcrToken reports whether the workflow can push to ghcr.io with its own GITHUB_TOKEN.
+// A GitHub Enterprise Server runner's token is for that server, not for ghcr.io.
+func ghcrToken(config *models.ProjectConfig) bool {
+	docker := config.Config.Distributions.Docker
+	return docker.Registry == "ghcr.io" && generator.ProjectHost(config.Project) == forge.DefaultGitHubHost
+}
+
+func projectForge(config *models.ProjectConfig) forge.Kind {
+	if config == nil {
+		return forge.GitHub
+	}
+	return generator.ProjectForge(config.Project)
+}
+
 func GetRequiredSecrets(config *models.ProjectConfig) []string {
+	if projectForge(config).IsGitea() {
+		secrets := []string{"RELEASE_TOKEN"}
+		if config.Config != nil && config.Config.Distributions.NPM != nil && config.Config.Distributions.NPM.Enabled {
+			secrets = append(secrets, "NPM_TOKEN")
+		}
+		if aurEnabled(config) {
+			secrets = append(secrets, "AUR_KEY")
+		}
+		return secrets
+	}
+
 	secrets := []string{"GITHUB_TOKEN (automatic)"}
 
 	if config.Config != nil {
 		if config.Config.Distributions.NPM != nil && config.Config.Distributions.NPM.Enabled {
 			secrets = append(secrets, "NPM_TOKEN")
 		}
+		if docker := config.Config.Distributions.Docker; docker != nil && docker.Enabled && !ghcrToken(config) {
+			secrets = append(secrets, "REGISTRY_USERNAME", "REGISTRY_PASSWORD")
+		}
+		if aurEnabled(config) {
+			secrets = append(secrets, "AUR_KEY")
+		}
 	}
 
 	return secrets
 }
 
-func WriteWorkflowFile(projectPath, yamlContent string) error {
-	workflowDir := filepath.Join(projectPath, ".github", "workflows")
-	if err := os.MkdirAll(workflowDir, 0755); err != nil {
+func WriteWorkflowFile(projectPath string, config *models.ProjectConfig, yamlContent string) error {
+	workflowPath := filepath.Join(projectPath, WorkflowPath(config))
+	if err := os.MkdirAll(filepath.Dir(workflowPath), 0755); err != nil {
 		return fmt.Errorf("creating workflow directory: %w", err)
 	}
 
-	workflowPath := filepath.Join(workflowDir, "release.yml")
-
 	tempFile := workflowPath + ".tmp"
 	if err := os.WriteFile(tempFile, []byte(yamlContent), 0644); err != nil {
 		return fmt.Errorf("writing temp file: %w", err)
@@ -76,8 +155,45 @@ func WriteWorkflowFile(projectPath, yamlContent string) error {
 	return nil
 }
 
-func WorkflowExists(projectPath string) bool {
-	workflowPath := filepath.Join(projectPath, ".github", "workflows", "release.yml")
+func WorkflowExists(projectPath string, config *models.ProjectConfig) bool {
+	workflowPath := filepath.Join(projectPath, WorkflowPath(config))
 	_, err := os.Stat(workflowPath)
 	return err == nil
 }
+
+// ForgejoData fills the Gitea/Forgejo Actions template
+type ForgejoData struct {
+	RunsOn       string
+	ActionsURL   string // prefix for actions not on the instance's default mirror
+	IncludeTests bool
+	NPMEnabled   bool
+	AUREnabled   bool
+}
+
+// generateForgejoWorkflow writes a Gitea or Forgejo Actions workflow. Those runners have
+// no automatic token that reaches other repos, so taps use the RELEASE_TOKEN secret.
+func generateForgejoWorkflow(config *models.ProjectConfig, kind forge.Kind) (string, error) {
+	tmpl, err := template.New("forgejo").Parse(forgejoWorkflowTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing template: %w", err)
+	}
+
+	data := ForgejoData{RunsOn: "ubuntu-latest", IncludeTests: true}
+	if kind == forge.Forgejo {
+		data.RunsOn = "docker"
+		data.ActionsURL = "https://code.forgejo.org/"
+	}
+	if config.Config != nil {
+		if config.Config.CICD != nil && config.Config.CICD.GitHubActions != nil {
+			data.IncludeTests = config.Config.CICD.GitHubActions.IncludeTests
+		}
+		data.NPMEnabled = config.Config.Distributions.NPM != nil && config.Config.Distributions.NPM.Enabled
+		data.AUREnabled = aurEnabled(config)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return buf.String(), nil
+}
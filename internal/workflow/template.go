@@ -1,6 +1,7 @@
 package workflow
 
-const workflowTemplate = `name: Release
+const workflowTemplate = `# Generated by distui
+name: Release
 
 on:
   push:
@@ -10,6 +11,11 @@ on:
 jobs:
   release:
     runs-on: ubuntu-latest
+{{- if .GHCR}}
+    permissions:
+      contents: write
+      packages: write
+{{- end}}
     steps:
       - uses: actions/checkout@v4
         with:
@@ -22,6 +28,23 @@ jobs:
 {{- if .IncludeTests}}
       - name: Run tests
         run: go test ./...
+{{- end}}
+{{- if .DockerEnabled}}
+
+      - uses: docker/setup-qemu-action@v3
+
+      - uses: docker/setup-buildx-action@v3
+
+      - uses: docker/login-action@v3
+        with:
+          registry: {{.DockerRegistry}}
+{{- if .GHCR}}
+          username: ${{"{{"}} github.actor {{"}}"}}
+          password: ${{"{{"}} secrets.GITHUB_TOKEN {{"}}"}}
+{{- else}}
+          username: ${{"{{"}} secrets.REGISTRY_USERNAME {{"}}"}}
+          password: ${{"{{"}} secrets.REGISTRY_PASSWORD {{"}}"}}
+{{- end}}
 {{- end}}
 
       - uses: goreleaser/goreleaser-action@v5
@@ -33,4 +56,48 @@ jobs:
 {{- if .NPMEnabled}}
           NPM_TOKEN: ${{"{{"}} secrets.NPM_TOKEN {{"}}"}}
 {{- end}}
+{{- if .AUREnabled}}
+          AUR_KEY: ${{"{{"}} secrets.AUR_KEY {{"}}"}}
+{{- end}}
+`
+
+// forgejoWorkflowTemplate runs on Gitea and Forgejo Actions. Secret names may not start
+// with GITEA_ or GITHUB_, hence RELEASE_TOKEN.
+const forgejoWorkflowTemplate = `# Generated by distui
+name: Release
+
+on:
+  push:
+    tags: ['v*']
+  workflow_dispatch:
+
+jobs:
+  release:
+    runs-on: {{.RunsOn}}
+    steps:
+      - uses: {{.ActionsURL}}actions/checkout@v4
+        with:
+          fetch-depth: 0
+
+      - uses: {{.ActionsURL}}actions/setup-go@v5
+        with:
+          go-version: '1.21'
+
+{{- if .IncludeTests}}
+      - name: Run tests
+        run: go test ./...
+{{- end}}
+
+      - uses: https://github.com/goreleaser/goreleaser-action@v5
+        with:
+          version: latest
+          args: release --clean
+        env:
+          GITEA_TOKEN: ${{"{{"}} secrets.RELEASE_TOKEN {{"}}"}}
+{{- if .NPMEnabled}}
+          NPM_TOKEN: ${{"{{"}} secrets.NPM_TOKEN {{"}}"}}
+{{- end}}
+{{- if .AUREnabled}}
+          AUR_KEY: ${{"{{"}} secrets.AUR_KEY {{"}}"}}
+{{- end}}
 `
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"distui/handlers"
+	"distui/internal/models"
+)
+
+// TestUserFlow_RegenerateWithDiffPreview
+// Regenerating release files shows a diff per file and only writes accepted files
+func TestUserFlow_RegenerateWithDiffPreview(t *testing.T) {
+	projectDir := t.TempDir()
+
+	oldYaml := "# Generated by distui\nversion: 2\n"
+	oldPkg := "{\n  \"_comment\": \"Generated by distui\",\n  \"name\": \"old\"\n}\n"
+	os.WriteFile(filepath.Join(projectDir, ".goreleaser.yaml"), []byte(oldYaml), 0644)
+	os.WriteFile(filepath.Join(projectDir, "package.json"), []byte(oldPkg), 0644)
+
+	project := &models.ProjectInfo{
+		Path:       projectDir,
+		Repository: &models.RepositoryInfo{Owner: "user", Name: "tool"},
+		Binary:     &models.BinaryInfo{Name: "tool"},
+		Module:     &models.ModuleInfo{Name: "github.com/user/tool", Version: "v1.0.0"},
+	}
+	projectConfig := &models.ProjectConfig{
+		Config: &models.ProjectSettings{
+			Distributions: models.Distributions{
+				GitHubRelease: &models.GitHubReleaseConfig{Enabled: true},
+				NPM:           &models.NPMConfig{Enabled: false},
+			},
+			CICD: &models.CICDSettings{
+				GitHubActions: &models.GitHubActionsConfig{Enabled: true, IncludeTests: true},
+			},
+		},
+	}
+
+	changes := handlers.GetConfigFileChanges(project, projectConfig)
+	diffs, err := handlers.BuildConfigDiffs(project, projectConfig, changes.FilesToGenerate, changes.FilesToDelete)
+	if err != nil {
+		t.Fatalf("BuildConfigDiffs failed: %v", err)
+	}
+
+	byName := map[string]handlers.FileDiff{}
+	for _, d := range diffs {
+		byName[d.Name] = d
+	}
+
+	if d, ok := byName[".goreleaser.yaml"]; !ok || d.Action != handlers.FileUpdate {
+		t.Errorf("Expected .goreleaser.yaml update, got %+v", byName)
+	}
+	if d, ok := byName["package.json"]; !ok || d.Action != handlers.FileDelete {
+		t.Errorf("Expected package.json delete, got %+v", byName)
+	}
+	if d, ok := byName[".github/workflows/release.yml"]; !ok || d.Action != handlers.FileCreate {
+		t.Errorf("Expected workflow create, got %+v", byName)
+	}
+
+	// Reject the goreleaser change, accept the rest
+	for i := range diffs {
+		if diffs[i].Name == ".goreleaser.yaml" {
+			diffs[i].Accepted = false
+		}
+	}
+
+	if err := handlers.ApplyConfigDiffs(projectDir, diffs); err != nil {
+		t.Fatalf("ApplyConfigDiffs failed: %v", err)
+	}
+
+	data, _ := os.ReadFile(filepath.Join(projectDir, ".goreleaser.yaml"))
+	if string(data) != oldYaml {
+		t.Error("Rejected file was modified")
+	}
+	if _, err := os.Stat(filepath.Join(projectDir, "package.json")); !os.IsNotExist(err) {
+		t.Error("Accepted delete was not applied")
+	}
+	workflow, err := os.ReadFile(filepath.Join(projectDir, ".github/workflows/release.yml"))
+	if err != nil || string(workflow) != byName[".github/workflows/release.yml"].New {
+		t.Error("Workflow not written with previewed content")
+	}
+}
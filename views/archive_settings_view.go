@@ -0,0 +1,66 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/handlers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderArchiveSettings renders the archive naming/contents editor
+func RenderArchiveSettings(model *handlers.ArchiveSettingsModel) string {
+	if model == nil {
+		return "Loading archive settings..."
+	}
+
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render("ARCHIVE CONTENTS & NAMING"))
+	content.WriteString("\n\n")
+
+	field := func(index int, label, value string) {
+		line := fmt.Sprintf("%-18s %s", label, value)
+		if model.Focus == index {
+			content.WriteString(selectedStyle.Render("> "+line) + "\n")
+		} else {
+			content.WriteString(normalStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	field(handlers.ArchiveFieldName, "Name template:", model.NameInput.View())
+	field(handlers.ArchiveFieldFiles, "Extra files:", model.FilesInput.View())
+
+	wrap := "[ ]"
+	if model.Wrap {
+		wrap = "[✓]"
+	}
+	field(handlers.ArchiveFieldWrap, "Wrap in directory:", wrap)
+
+	labels := map[string]string{"linux": "Linux format:", "darwin": "macOS format:", "windows": "Windows format:"}
+	for i, goos := range []string{"linux", "darwin", "windows"} {
+		field(handlers.ArchiveFieldLinux+i, labels[goos], "‹ "+model.Formats[goos]+" ›")
+	}
+	content.WriteString("\n")
+
+	content.WriteString(dimStyle.Render("Example release v1.2.3:") + "\n")
+	for _, line := range model.Preview {
+		if strings.HasPrefix(line, "✗") {
+			content.WriteString("  " + errorStyle.Render(line) + "\n")
+		} else {
+			content.WriteString("  " + normalStyle.Render(line) + "\n")
+		}
+	}
+	content.WriteString("\n")
+
+	if model.Error != "" {
+		content.WriteString(errorStyle.Render("✗ "+model.Error) + "\n\n")
+	}
+
+	content.WriteString(dimStyle.Render("Template fields: .ProjectName .Version .Tag .Os .Arch  •  Files are comma separated globs") + "\n")
+	content.WriteString(dimStyle.Render("[Tab/↑/↓] Field  [Space/←/→] Change  [Enter] Save  [ESC] Cancel"))
+
+	return content.String()
+}
@@ -0,0 +1,104 @@
+package views
+
+import (
+	"strings"
+
+	"distui/handlers"
+	"distui/internal/brew"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderBrewPreview shows the lint and audit results above the rendered formula or cask
+func RenderBrewPreview(configModel *handlers.ConfigureModel) string {
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117")).
+		Bold(true)
+
+	infoStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	successStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("82"))
+
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196"))
+
+	codeStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("252"))
+
+	var content strings.Builder
+	used := 0
+	writeLine := func(line string) {
+		content.WriteString(line + "\n")
+		used++
+	}
+
+	preview := configModel.BrewPreview
+	if preview == nil {
+		writeLine(headerStyle.Render("HOMEBREW PREVIEW"))
+		writeLine("")
+		if configModel.BrewPreviewError != "" {
+			writeLine(errorStyle.Render("✗ " + configModel.BrewPreviewError))
+			writeLine("")
+		}
+		content.WriteString(infoStyle.Render("[t] Render Again  [ESC] Back"))
+		return content.String()
+	}
+
+	writeLine(headerStyle.Render("HOMEBREW PREVIEW  " + preview.File))
+	writeLine(infoStyle.Render("Version " + brew.PreviewVersion + " with made up checksums, rendered from your .goreleaser.yaml"))
+	writeLine("")
+
+	if len(preview.Problems) == 0 {
+		writeLine(successStyle.Render("✓ Lint passed"))
+	} else {
+		writeLine(errorStyle.Render("✗ Lint"))
+		for _, problem := range preview.Problems {
+			writeLine(infoStyle.Render("    → " + problem))
+		}
+	}
+
+	switch {
+	case !preview.Audited:
+		writeLine(infoStyle.Render("- brew audit skipped, brew is not installed"))
+	case preview.AuditErr != "":
+		writeLine(errorStyle.Render("✗ " + preview.AuditErr))
+		for _, line := range strings.Split(preview.Audit, "\n") {
+			if strings.TrimSpace(line) != "" {
+				writeLine(infoStyle.Render("    " + line))
+			}
+		}
+	default:
+		writeLine(successStyle.Render("✓ brew audit passed"))
+	}
+	writeLine("")
+
+	lines := strings.Split(strings.TrimRight(preview.Content, "\n"), "\n")
+	// Room left after the results and the footer (2)
+	visible := configModel.Height - used - 6
+	if visible < 5 {
+		visible = 5
+	}
+	offset := preview.Offset
+	if offset > len(lines)-visible {
+		offset = len(lines) - visible
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	end := offset + visible
+	if end > len(lines) {
+		end = len(lines)
+	}
+	for _, line := range lines[offset:end] {
+		if configModel.Width > 0 {
+			line = lipgloss.NewStyle().MaxWidth(configModel.Width - 4).Render(line)
+		}
+		content.WriteString(codeStyle.Render(line) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString(infoStyle.Render("[↑/↓] Scroll  [t] Render Again  [ESC] Back"))
+
+	return content.String()
+}
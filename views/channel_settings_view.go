@@ -0,0 +1,69 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/handlers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderChannelSettings renders the settings editor of a distribution channel
+func RenderChannelSettings(model *handlers.ChannelSettingsModel) string {
+	if model == nil {
+		return "Loading settings..."
+	}
+
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(model.Title))
+	content.WriteString("\n\n")
+
+	for i, field := range model.Fields {
+		value := field.Input.View()
+		if len(field.Options) > 0 {
+			value = renderChannelOptions(field, model.Focus == i)
+		}
+		line := fmt.Sprintf("%-20s %s", field.Label, value)
+		if model.Focus == i {
+			content.WriteString(selectedStyle.Render("> "+line) + "\n")
+		} else {
+			content.WriteString(normalStyle.Render("  "+line) + "\n")
+		}
+	}
+	content.WriteString("\n")
+
+	if model.Hint != "" {
+		content.WriteString(dimStyle.Render(model.Hint) + "\n\n")
+	}
+
+	if model.Error != "" {
+		content.WriteString(errorStyle.Render("✗ "+model.Error) + "\n\n")
+	}
+
+	if model.Confirm != "" {
+		content.WriteString(selectedStyle.Render(model.Confirm))
+		return content.String()
+	}
+
+	content.WriteString(dimStyle.Render("[Tab/↑/↓] Field  [Enter] Save  [ESC] Cancel"))
+
+	return content.String()
+}
+
+func renderChannelOptions(field handlers.ChannelField, focused bool) string {
+	options := make([]string, len(field.Options))
+	for i, option := range field.Options {
+		box := "[ ]"
+		if field.Selected[i] {
+			box = "[✓]"
+		}
+		if focused && field.Cursor == i {
+			option = "‹" + option + "›"
+		}
+		options[i] = box + " " + option
+	}
+	return strings.Join(options, "  ")
+}
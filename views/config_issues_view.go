@@ -0,0 +1,88 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/handlers"
+	"distui/internal/goreleaser"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderConfigIssues lists schema validation findings for the project's goreleaser config
+func RenderConfigIssues(configModel *handlers.ConfigureModel) string {
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117")).
+		Bold(true)
+
+	infoStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	successStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("82"))
+
+	var content strings.Builder
+
+	content.WriteString(headerStyle.Render("GORELEASER CONFIG CHECK: "+configModel.ConfigIssuesFile) + "\n\n")
+
+	if len(configModel.ConfigIssues) == 0 {
+		content.WriteString(successStyle.Render("✓ No problems found") + "\n\n")
+	} else {
+		for _, line := range RenderIssueLines(configModel.ConfigIssues, configModel.Width) {
+			content.WriteString(line + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	content.WriteString(infoStyle.Render("Checked offline against the GoReleaser v2 schema. Errors block releases.") + "\n")
+	if goreleaser.HasFixable(configModel.ConfigIssues) {
+		content.WriteString(infoStyle.Render("[u] Upgrade deprecated keys  [ESC] Back"))
+	} else {
+		content.WriteString(infoStyle.Render("[ESC] Back"))
+	}
+
+	return content.String()
+}
+
+// RenderIssueLines renders one line per issue plus an indented suggestion
+func RenderIssueLines(issues []goreleaser.Issue, width int) []string {
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196"))
+
+	warningStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("214"))
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	var lines []string
+	for _, issue := range issues {
+		icon, style := "✗", errorStyle
+		if issue.Severity == goreleaser.SeverityWarning {
+			icon, style = "⚠", warningStyle
+		}
+		line := fmt.Sprintf("%s %d:%d %s", icon, issue.Line, issue.Column, issue.Message)
+		if width > 0 {
+			line = lipgloss.NewStyle().MaxWidth(width).Render(line)
+		}
+		lines = append(lines, style.Render(line))
+		if issue.Suggestion != "" {
+			lines = append(lines, hintStyle.Render("    → "+issue.Suggestion))
+		}
+	}
+	return lines
+}
+
+// issueSummary returns e.g. "2 errors, 1 warning"
+func issueSummary(issues []goreleaser.Issue) string {
+	errs := len(goreleaser.Errors(issues))
+	warnings := len(issues) - errs
+	return fmt.Sprintf("%d %s, %d %s", errs, plural(errs, "error"), warnings, plural(warnings, "warning"))
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"distui/handlers"
+	"distui/internal/goreleaser"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -66,7 +67,7 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 	case handlers.CommitView:
 		return RenderCommitView(configModel.CommitModel)
 	case handlers.GenerateConfigConsent:
-		return RenderGenerateConfigConsent(configModel.PendingGenerateFiles, configModel.PendingDeleteFiles, configModel.Width, configModel.Height)
+		return RenderConfigDiff(configModel.ConfigDiff, configModel.Width, configModel.Height)
 	case handlers.SmartCommitPrefsView:
 		return RenderSmartCommitPrefs(configModel.SmartCommitPrefsModel)
 	case handlers.RepoCleanupView:
@@ -76,6 +77,16 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 		return "Loading cleanup view..."
 	case handlers.ModeSwitchWarning:
 		return RenderModeSwitchWarning(configModel.FilesToOverwrite)
+	case handlers.ConfigIssuesView:
+		return RenderConfigIssues(configModel)
+	case handlers.ArchiveSettingsView:
+		return RenderArchiveSettings(configModel.ArchiveModel)
+	case handlers.ChannelSettingsView:
+		return RenderChannelSettings(configModel.ChannelModel)
+	case handlers.PackageCheckView:
+		return RenderPackageCheck(configModel)
+	case handlers.BrewPreviewView:
+		return RenderBrewPreview(configModel)
 	}
 
 	headerStyle := lipgloss.NewStyle().
@@ -111,9 +122,17 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 
 	// Custom mode banner
 	if configModel.ProjectConfig != nil && configModel.ProjectConfig.CustomFilesMode {
+		bannerText := "Using custom files - Press [M] to let distui manage builds/brews/release/changelog, [C] to switch to distui-managed mode"
+		if configModel.ProjectConfig.MergeCustomGoReleaser {
+			bannerText = "Using custom files - distui manages builds/brews/release/changelog in .goreleaser.yaml, [C] to switch to distui-managed mode"
+		}
+		if configModel.CreateStatus != "" && !configModel.IsCreating && configModel.ActiveTab != 0 {
+			bannerText = configModel.CreateStatus
+		}
 		customBanner := lipgloss.NewStyle().
 			Foreground(lipgloss.Color("117")).
-			Render("Using custom files - Press [C] to switch to distui-managed mode")
+			Width(configModel.Width).
+			Render(bannerText)
 		content.WriteString(customBanner)
 		content.WriteString("\n\n")
 	}
@@ -289,6 +308,9 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 						if acc.IsOrg {
 							accType = "org"
 						}
+						if acc.Host != "" {
+							accType += ", " + acc.Host
+						}
 
 						parts = append(parts, fmt.Sprintf("%s %s (%s)", checkbox, acc.Username, accType))
 					}
@@ -317,6 +339,9 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 						if acc.IsOrg {
 							accType = "org"
 						}
+						if acc.Host != "" {
+							accType += ", " + acc.Host
+						}
 
 						form.WriteString(fmt.Sprintf("%s %s (%s)", checkbox, acc.Username, accType))
 
@@ -414,6 +439,20 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 		content.WriteString("\n" + warningStyle.Render("⚠ Configuration changed - Press [R] to regenerate release files"))
 	}
 
+	// Show schema problems in the goreleaser config on disk
+	if len(configModel.ConfigIssues) > 0 && configModel.ActiveTab != 0 {
+		issueColor := lipgloss.Color("214")
+		if goreleaser.HasErrors(configModel.ConfigIssues) {
+			issueColor = lipgloss.Color("196")
+		}
+		issueStyle := lipgloss.NewStyle().Foreground(issueColor)
+		hint := "Press [v] to view"
+		if goreleaser.HasFixable(configModel.ConfigIssues) {
+			hint = "Press [v] to view, [u] to upgrade deprecated keys"
+		}
+		content.WriteString("\n" + issueStyle.Render(fmt.Sprintf("⚠ %s: %s - %s", configModel.ConfigIssuesFile, issueSummary(configModel.ConfigIssues), hint)))
+	}
+
 	// Show appropriate controls based on active tab
 	controlLine1 := ""
 	controlLine2 := ""
@@ -425,11 +464,20 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 		controlLine2 = "[Tab] Next Tab  [ESC] Cancel  [↑/↓] Navigate"
 	} else if configModel.ActiveTab == 1 {
 		// Distributions tab - show hint about editing package name
-		controlLine1 = "[Space] Toggle  [a] Check All  [e] Edit Package  [Tab] Next Tab"
+		controlLine1 = "[Space] Toggle  [a] Check All  [e] Edit Settings  [Tab] Next Tab"
+		if dist, ok := configModel.Lists[1].SelectedItem().(handlers.DistributionItem); ok && dist.Key == "nfpm" {
+			controlLine1 = "[Space] Toggle  [e] Edit Settings  [t] Test Packages  [Tab] Next Tab"
+		}
+		if dist, ok := configModel.Lists[1].SelectedItem().(handlers.DistributionItem); ok && dist.Key == "homebrew" {
+			controlLine1 = "[Space] Toggle  [e] Edit Settings  [t] Preview Formula  [Tab] Next Tab"
+		}
 		controlLine2 = "[R] Confirm & Generate Release Files  [ESC] Back"
 	} else {
 		// Other tabs controls
 		controlLine1 = "[Space] Toggle  [a] Check All  [Tab] Next Tab"
+		if configModel.ActiveTab == 2 {
+			controlLine1 = "[Space] Toggle  [e] Edit Archives  [Tab] Next Tab"
+		}
 		controlLine2 = "[R] Confirm & Generate Release Files  [ESC] Back"
 	}
 
@@ -456,7 +504,7 @@ func RenderConfigureContent(project string, configModel *handlers.ConfigureModel
 	return content.String()
 }
 
-// renderNPMStatusUI renders the NPM package name status UI (checking, available, unavailable with suggestions, edit mode)
+// renderNPMStatusUI renders the NPM package name status UI (checking, available, unavailable with suggestions)
 func renderNPMStatusUI(configModel *handlers.ConfigureModel) string {
 	if configModel == nil {
 		return ""
@@ -465,17 +513,7 @@ func renderNPMStatusUI(configModel *handlers.ConfigureModel) string {
 	var npmUI strings.Builder
 	spinnerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("69"))
 
-	// Show NPM name edit UI if in edit mode
-	if configModel.NPMEditMode {
-		editStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("117"))
-		dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
-		npmUI.WriteString("  " + editStyle.Render("Edit NPM Package Name:"))
-		npmUI.WriteString("\n  " + editStyle.Render(configModel.NPMNameInput.View()))
-		npmUI.WriteString("\n  " + dimStyle.Render("[Enter] Save  [ESC] Cancel"))
-		return npmUI.String()
-	}
-
-	// Show NPM name validation status (only when not editing)
+	// Show NPM name validation status
 	if configModel.NPMNameStatus != "" {
 		switch configModel.NPMNameStatus {
 		case "checking":
@@ -508,7 +546,7 @@ func renderNPMStatusUI(configModel *handlers.ConfigureModel) string {
 					}
 					npmUI.WriteString("\n    → " + suggestionStyle.Render(suggestion))
 				}
-				npmUI.WriteString("\n  " + dimStyle.Render("To change: ESC > s > e"))
+				npmUI.WriteString("\n  " + dimStyle.Render("To change: [e] Edit Settings"))
 			}
 		case "error":
 			errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
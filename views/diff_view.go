@@ -0,0 +1,170 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"distui/handlers"
+	"distui/internal/textdiff"
+)
+
+// RenderDiffLines renders a colored unified diff, one string per output line
+func RenderDiffLines(oldContent, newContent string) []string {
+	addStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
+	delStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	hunkStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("117"))
+	ctxStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var lines []string
+	for _, hunk := range textdiff.Hunks(oldContent, newContent, 3) {
+		lines = append(lines, hunkStyle.Render(hunk.Header()))
+		for _, line := range hunk.Lines {
+			switch line.Kind {
+			case textdiff.Insert:
+				lines = append(lines, addStyle.Render("+ "+line.Text))
+			case textdiff.Delete:
+				lines = append(lines, delStyle.Render("- "+line.Text))
+			default:
+				lines = append(lines, ctxStyle.Render("  "+line.Text))
+			}
+		}
+	}
+	return lines
+}
+
+func RenderConfigDiff(model *handlers.ConfigDiffModel, width, height int) string {
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117")).
+		Bold(true)
+
+	infoStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117")).
+		Bold(true)
+
+	rejectedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Strikethrough(true)
+
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196"))
+
+	successStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("82")).
+		Bold(true)
+
+	controlStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	var content strings.Builder
+
+	title := "UPDATE RELEASE CONFIGURATION"
+	if model != nil && model.Title != "" {
+		title = model.Title
+	}
+	content.WriteString(headerStyle.Render(title) + "\n\n")
+
+	if model == nil || len(model.Files) == 0 {
+		content.WriteString(infoStyle.Render("No changes needed - configuration is up to date.") + "\n\n")
+		content.WriteString(controlStyle.Render("[ESC] Return"))
+		return content.String()
+	}
+
+	// File list with per-file accept state
+	for i, file := range model.Files {
+		checkbox := "[ ]"
+		if file.Accepted {
+			checkbox = "[✓]"
+		}
+		added, removed := diffStats(file.Old, file.New)
+		line := fmt.Sprintf("%s %s (%s, +%d -%d)", checkbox, file.Name, file.Action, added, removed)
+
+		prefix := "  "
+		if i == model.Selected {
+			prefix = "> "
+			line = selectedStyle.Render(line)
+		} else if !file.Accepted {
+			line = rejectedStyle.Render(line)
+		}
+		content.WriteString(prefix + line + "\n")
+	}
+	content.WriteString("\n")
+
+	current := model.Current()
+	lines := RenderDiffLines(current.Old, current.New)
+
+	// Header (2) + file list + blank (1) + notes + footer (5)
+	visible := height - len(model.Files) - len(current.Notes) - 8
+	if visible < 5 {
+		visible = 5
+	}
+	offset := model.Offset
+	if offset > len(lines)-visible {
+		offset = len(lines) - visible
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	end := offset + visible
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	for _, line := range lines[offset:end] {
+		if width > 0 {
+			line = lipgloss.NewStyle().MaxWidth(width).Render(line)
+		}
+		content.WriteString(line + "\n")
+	}
+	if len(lines) > visible {
+		content.WriteString(infoStyle.Render(fmt.Sprintf("(%d-%d of %d lines)", offset+1, end, len(lines))) + "\n")
+	}
+	content.WriteString("\n")
+
+	for _, note := range current.Notes {
+		content.WriteString(infoStyle.Render("• "+note) + "\n")
+	}
+	if len(current.Notes) > 0 {
+		content.WriteString("\n")
+	}
+
+	// Schema check of the proposed goreleaser config
+	if len(current.Issues) > 0 {
+		issueLines := RenderIssueLines(current.Issues, width)
+		if len(issueLines) > 6 {
+			issueLines = append(issueLines[:6], infoStyle.Render(fmt.Sprintf("... %s in total", issueSummary(current.Issues))))
+		}
+		for _, line := range issueLines {
+			content.WriteString(line + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	if model.Error != "" {
+		content.WriteString(errorStyle.Render("✗ "+model.Error) + "\n")
+	} else {
+		content.WriteString(successStyle.Render(fmt.Sprintf("%d of %d files will be written when you press [y], rejected files stay as they are", model.AcceptedCount(), len(model.Files))) + "\n")
+	}
+
+	content.WriteString(controlStyle.Render("[Space] Accept/Reject  [a] All  [Tab/←/→] File  [↑/↓] Scroll  [y] Apply  [n/ESC] Cancel"))
+
+	return content.String()
+}
+
+// diffStats counts inserted and deleted lines
+func diffStats(oldContent, newContent string) (int, int) {
+	added, removed := 0, 0
+	for _, line := range textdiff.Lines(oldContent, newContent) {
+		switch line.Kind {
+		case textdiff.Insert:
+			added++
+		case textdiff.Delete:
+			removed++
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,56 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/handlers"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderPackageCheck shows what the snapshot build produced and what doesn't match the settings
+func RenderPackageCheck(configModel *handlers.ConfigureModel) string {
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("117")).
+		Bold(true)
+
+	infoStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244"))
+
+	successStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("82"))
+
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196"))
+
+	var content strings.Builder
+
+	content.WriteString(headerStyle.Render("LINUX PACKAGE CHECK") + "\n\n")
+
+	if configModel.PackageCheckError != "" {
+		content.WriteString(errorStyle.Render("✗ "+configModel.PackageCheckError) + "\n\n")
+	}
+
+	for _, report := range configModel.PackageReports {
+		title := report.Format
+		if report.File != "" {
+			title = fmt.Sprintf("%s  %s %s (%s)", report.File, report.Name, report.Version, report.Arch)
+		}
+		if len(report.Problems) == 0 {
+			content.WriteString(successStyle.Render("✓ "+title) + "\n")
+			continue
+		}
+		content.WriteString(errorStyle.Render("✗ "+title) + "\n")
+		for _, problem := range report.Problems {
+			content.WriteString(infoStyle.Render("    → "+problem) + "\n")
+		}
+	}
+	if len(configModel.PackageReports) > 0 {
+		content.WriteString("\n")
+	}
+
+	content.WriteString(infoStyle.Render("Built with goreleaser --snapshot, packages are left in dist/.") + "\n")
+	content.WriteString(infoStyle.Render("[t] Test Again  [ESC] Back"))
+
+	return content.String()
+}
@@ -421,6 +421,8 @@ func renderCompactVersionSelect(m *handlers.ReleaseModel) string {
 		content.WriteString("\n" + fieldStyle.Render("Changelog: ") + m.ChangelogInput.View() + "\n")
 	}
 
+	content.WriteString(renderModulePathWarning(m))
+
 	content.WriteString("\n" + subtleStyle.Render("↑/↓: navigate • enter: start • esc: cancel"))
 
 	return content.String()
@@ -113,11 +113,36 @@ func RenderVersionSelection(m *handlers.ReleaseModel) string {
 		content.WriteString("\n" + releaseFieldStyle.Render("Changelog: ") + m.ChangelogInput.View() + "\n")
 	}
 
+	content.WriteString(renderModulePathWarning(m))
+
 	content.WriteString("\n" + releaseSubtleStyle.Render("↑/↓: navigate • enter: start release • esc: back"))
 
 	return content.String()
 }
 
+// renderModulePathWarning explains why a v2+ tag needs a /vN module path and
+// shows whether distui will rewrite go.mod before tagging
+func renderModulePathWarning(m *handlers.ReleaseModel) string {
+	if m.ModulePathCheck == nil || !m.ModulePathCheck.NeedsUpdate {
+		return ""
+	}
+
+	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+
+	var b strings.Builder
+	b.WriteString("\n" + releaseFieldStyle.Render(warningStyle.Render(fmt.Sprintf("⚠ %s is a major version bump", m.ModulePathCheck.Version))) + "\n")
+	b.WriteString(releaseFieldStyle.Render(releaseSubtleStyle.Render(fmt.Sprintf("go.mod declares %s", m.ModulePathCheck.CurrentPath))) + "\n")
+	b.WriteString(releaseFieldStyle.Render(releaseSubtleStyle.Render(fmt.Sprintf("Go requires %s for this tag", m.ModulePathCheck.ExpectedPath))) + "\n")
+
+	checkbox := "[ ]"
+	if m.UpdateModulePath {
+		checkbox = "[✓]"
+	}
+	b.WriteString(releaseFieldStyle.Render(fmt.Sprintf("%s [m] Rewrite module path and imports, commit before tagging", checkbox)) + "\n")
+
+	return b.String()
+}
+
 func RenderProgress(m *handlers.ReleaseModel) string {
 	var content strings.Builder
 
@@ -198,19 +223,56 @@ func RenderSuccess(m *handlers.ReleaseModel) string {
 			fmt.Sprintf("Successfully completed %d/%d steps", successCount, len(m.Packages))))
 	}
 
-	// GitHub release reminder
+	if len(m.Links) > 0 {
+		content.WriteString("\n\n" + releaseHeaderStyle.Render("LINKS"))
+		for _, link := range m.Links {
+			content.WriteString("\n  " + releaseValueStyle.Render(link))
+		}
+	}
+
+	content.WriteString("\n\n" + releaseHeaderStyle.Render("VERIFICATION"))
+	if m.Verifying {
+		content.WriteString("\n  " + m.Spinner.View() + " " + releaseSubtleStyle.Render("Checking every channel..."))
+	} else {
+		content.WriteString(renderVerification(m.Verification))
+	}
+
+	// Release page reminder
 	if m.RepoOwner != "" && m.RepoName != "" && m.Version != "" {
-		githubURL := fmt.Sprintf("https://github.com/%s/%s/releases/tag/%s", m.RepoOwner, m.RepoName, m.Version)
+		githubURL := m.Repo.ReleaseURL(m.Version)
 		reminderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("117"))
 		content.WriteString("\n\n" + reminderStyle.Render("→ Head over to " + githubURL))
 		content.WriteString("\n" + reminderStyle.Render("  to edit the release and tell your users what changed!"))
 	}
 
-	content.WriteString("\n\n" + releaseSubtleStyle.Render("Press ESC to return"))
+	content.WriteString("\n\n" + releaseSubtleStyle.Render("Press ESC to return • v to verify again"))
 
 	return content.String()
 }
 
+// renderVerification draws one row per channel: mark, channel, detail
+func renderVerification(checks []models.ChannelCheck) string {
+	width := 0
+	for _, check := range checks {
+		width = max(width, len(check.Channel))
+	}
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	var rows strings.Builder
+	for _, check := range checks {
+		channel := fmt.Sprintf("%-*s", width, check.Channel)
+		switch {
+		case check.Skipped:
+			rows.WriteString("\n  " + releaseSubtleStyle.Render("– "+channel+"  "+check.Detail))
+		case check.OK:
+			rows.WriteString("\n  " + releaseCheckMark.String() + " " + channel + "  " + releaseSubtleStyle.Render(check.Detail))
+		default:
+			rows.WriteString("\n  " + releaseCrossMark.String() + " " + channel + "  " + failStyle.Render(check.Detail))
+		}
+	}
+	return rows.String()
+}
+
 func RenderFailure(m *handlers.ReleaseModel) string {
 	var content strings.Builder
 
@@ -29,6 +29,10 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 		return content.String()
 	}
 
+	if model.TapManager != nil && model.TapManager.Active {
+		return RenderTapManager(model.TapManager)
+	}
+
 	if model.Editing {
 		content.WriteString("Config file:  ~/.distui/config.yaml:\n\n")
 		content.WriteString("Configure distui settings:\n\n")
@@ -48,6 +52,7 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 				"Homebrew Tap:",
 				"NPM Scope:",
 				"Version Bump:",
+				"Other Forges:",
 			}[i]
 
 			content.WriteString(style.Render(fmt.Sprintf("%-20s", label)))
@@ -55,7 +60,11 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 			content.WriteString(model.Inputs[i].View())
 			if i == 1 {
 				content.WriteString("\n")
-				content.WriteString(subtleStyle.Render("                       (comma-separated, prefix with '@' for orgs: user1, @org1, user2)"))
+				content.WriteString(subtleStyle.Render("                       (comma-separated, '@' prefix for orgs, '@host' for Enterprise: user1, @org1, user2@ghe.corp)"))
+			}
+			if i == 5 {
+				content.WriteString("\n")
+				content.WriteString(subtleStyle.Render("                       (kind:user@host[=api-url], kind is gitlab, gitea or forgejo)"))
 			}
 			content.WriteString("\n")
 		}
@@ -69,7 +78,10 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 		}
 		content.WriteString(button)
 
-		if model.Saved {
+		if model.Error != "" {
+			content.WriteString("\n\n")
+			content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render("✗ " + model.Error))
+		} else if model.Saved {
 			content.WriteString("\n\n")
 			content.WriteString(focusedStyle.Render("✓ Settings saved!"))
 		}
@@ -118,6 +130,9 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 					if acc.IsOrg {
 						username = "@" + username
 					}
+					if acc.Host != "" {
+						username += " (" + acc.Host + ")"
+					}
 					content.WriteString(fmt.Sprintf("%s %s\n", prefix, username))
 				}
 			
@@ -128,12 +143,22 @@ func RenderSettingsContent(model *handlers.SettingsModel) string {
 			content.WriteString(fmt.Sprintf("\n  Homebrew Tap:   %s\n", model.Config.User.DefaultHomebrewTap))
 			content.WriteString(fmt.Sprintf("  NPM Scope:      %s\n", model.Config.User.NPMScope))
 			content.WriteString(fmt.Sprintf("  Version Bump:   %s\n", model.Config.Preferences.DefaultVersionBump))
+			if len(model.Config.User.ForgeAccounts) > 0 {
+				content.WriteString("\n  Other Forges:\n")
+				for _, acc := range model.Config.User.ForgeAccounts {
+					account := acc.Host
+					if acc.Username != "" {
+						account = acc.Username + "@" + acc.Host
+					}
+					content.WriteString(fmt.Sprintf("    • %s (%s)\n", account, acc.Kind))
+				}
+			}
 		} else {
 			content.WriteString("No configuration found.\n")
 		}
 
 		content.WriteString("\n")
-		content.WriteString(focusedStyle.Render("[e] Edit Settings"))
+		content.WriteString(focusedStyle.Render("[e] Edit Settings  [t] Homebrew Taps"))
 		content.WriteString("\n\n")
 		content.WriteString(subtleStyle.Render("p: project • g: global • tab: cycle • q: quit"))
 	}
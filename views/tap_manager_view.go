@@ -0,0 +1,98 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"distui/handlers"
+	"distui/internal/taps"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const tapManagerRows = 18
+
+// RenderTapManager lists every tap with its packages, flagging those behind their latest release
+func RenderTapManager(t *handlers.TapManager) string {
+	staleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	var content strings.Builder
+	content.WriteString(headerStyle.Render("HOMEBREW TAPS"))
+	content.WriteString("\n\n")
+
+	switch {
+	case t.Bootstrapping:
+		content.WriteString(fmt.Sprintf("Creating %s/%s on GitHub...\n\n", t.Owner, taps.DefaultTapName))
+	case t.Loading:
+		content.WriteString("Looking up taps and their formulas...\n\n")
+	case t.Err != "":
+		content.WriteString(errorStyle.Render("✗ "+t.Err) + "\n\n")
+	case len(t.Taps) == 0:
+		content.WriteString(subtleStyle.Render("No homebrew-* repositories found for your accounts.") + "\n\n")
+	default:
+		var rows []string
+		for _, tap := range t.Taps {
+			header := focusedStyle.Render(tap.Repo)
+			if tap.LocalPath != "" {
+				header += subtleStyle.Render("  " + tap.LocalPath)
+			}
+			if stale := tap.StaleCount(); stale > 0 {
+				header += staleStyle.Render(fmt.Sprintf("  %d stale", stale))
+			}
+			rows = append(rows, header)
+
+			if tap.Err != "" {
+				rows = append(rows, errorStyle.Render("    ✗ "+tap.Err))
+			} else if len(tap.Packages) == 0 {
+				rows = append(rows, subtleStyle.Render("    (empty)"))
+			}
+			for _, pkg := range tap.Packages {
+				kind := "formula"
+				if pkg.Cask {
+					kind = "cask"
+				}
+				version := pkg.Version
+				if version == "" {
+					version = "?"
+				}
+				line := fmt.Sprintf("    %-24s %-8s %-12s %s", pkg.Name, kind, version, pkg.SourceRepo)
+				switch {
+				case pkg.Stale:
+					rows = append(rows, staleStyle.Render(line+"  ⚠ "+pkg.Latest+" released"))
+				case pkg.Latest != "":
+					rows = append(rows, line+okStyle.Render("  ✓"))
+				default:
+					rows = append(rows, line)
+				}
+			}
+		}
+
+		offset := min(t.Offset, max(len(rows)-1, 0))
+		end := min(offset+tapManagerRows, len(rows))
+		for _, row := range rows[offset:end] {
+			content.WriteString(row + "\n")
+		}
+		if end < len(rows) {
+			content.WriteString(subtleStyle.Render(fmt.Sprintf("    ... %d more", len(rows)-end)) + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	if t.Status != "" {
+		content.WriteString(t.Status + "\n\n")
+	}
+
+	if t.ConfirmBootstrap {
+		content.WriteString(focusedStyle.Render(fmt.Sprintf("Create public repo %s/%s with a README, Formula/ and Casks/? [y/n]", t.Owner, taps.DefaultTapName)))
+		return content.String()
+	}
+
+	controls := "↑/↓: scroll • r: refresh • esc: back"
+	if t.CanBootstrap() && !t.Bootstrapping {
+		content.WriteString(focusedStyle.Render(fmt.Sprintf("[b] Create %s/%s", t.Owner, taps.DefaultTapName)))
+		content.WriteString("\n\n")
+	}
+	content.WriteString(subtleStyle.Render(controls))
+	return content.String()
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"distui/handlers"
+	"distui/internal/workflow"
 )
 
 func RenderWorkflowGen(model *handlers.WorkflowGenModel) string {
@@ -126,7 +127,7 @@ func renderConfirm(model *handlers.WorkflowGenModel) string {
 	}
 	content.WriteString("\n\n")
 
-	content.WriteString(normalStyle.Render("File: .github/workflows/release.yml"))
+	content.WriteString(normalStyle.Render("File: " + workflow.WorkflowPath(model.ProjectConfig)))
 	content.WriteString("\n\n")
 
 	content.WriteString(dimStyle.Render("[y] Yes  [n] No  [ESC] Cancel"))